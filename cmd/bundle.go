@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/internal/bundle"
+	"github.com/thatisuday/commando"
+	"os"
+)
+
+func AddBundleCommand(registry *commando.CommandRegistry) *commando.Command {
+	return registry.Register("bundle").
+		SetShortDescription("bundles a CLI specification file into a single file").
+		SetDescription("bundles a CLI specification spread across files into a single file, where every refers-to is inlined").
+		AddArgument("file", "the CLI specification file URI", "index.json").
+		AddArgument("target", "file which will contain the bundled specification (json or yaml)", "").
+		AddFlag("hoist", "keeps the referenced definitions under the top-level parameters, exit and schemas", commando.Bool, nil).
+		SetAction(doBundle)
+}
+
+func doBundle(args map[string]commando.ArgValue, flags map[string]commando.FlagValue) {
+	hoist, _ := flags["hoist"].GetBool()
+	ctx := getValidContext(args["file"].Value)
+
+	document, err := bundle.Bundle(ctx, hoist)
+
+	if err != nil {
+		fmt.Println(err)
+		fmt.Println("unexpected problem occurred")
+		os.Exit(1)
+	}
+
+	binary, err := internal.Serialize(document, args["target"].Value)
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	doWriteFile(args["target"].Value, binary)
+	fmt.Println("Document bundled")
+}
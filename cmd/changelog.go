@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/raitonbl/ant/internal/commands/diff"
+	"github.com/thatisuday/commando"
+	"os"
+)
+
+func AddChangelogCommand(registry *commando.CommandRegistry) *commando.Command {
+	return registry.Register("changelog").
+		SetShortDescription("writes the changelog between two versions of a CLI specification file").
+		SetDescription("writes a Markdown changelog section with the commands, arguments, flags and exit that changed between two versions of a CLI specification file").
+		AddArgument("old", "the CLI specification file URI of the previous version", "").
+		AddArgument("new", "the CLI specification file URI of the next version", "").
+		SetAction(doChangelog)
+}
+
+func doChangelog(args map[string]commando.ArgValue, _ map[string]commando.FlagValue) {
+	result, err := diff.Diff(getValidContext(args["old"].Value), getValidContext(args["new"].Value))
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	fmt.Print(string(diff.Changelog(result)))
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/internal/commands/lint"
+	"os"
+	"path/filepath"
+)
+
+// auto is the default of the arguments and flags which value is discovered (e.g. the file an object is exported into), since
+// commando requires every argument and flag which default is empty
+const auto = "auto"
+
+// getValidContext reads the specification, which must pass linting according to the .antlint.yaml next to it, if any
+func getValidContext(uri string) internal.ProjectContext {
+	ctx, err := internal.GetContext(uri)
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	configuration, err := lint.GetConfiguration(uri, "")
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	problems, err := lint.LintWith(ctx, configuration)
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if lint.IsFailure(problems, false) {
+		binary, err := lint.Report(lint.Text, uri, problems)
+
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		fmt.Print(string(binary))
+		fmt.Println("Document isn't valid")
+		os.Exit(2)
+	}
+
+	return ctx
+}
+
+func doWriteFile(path string, binary []byte) {
+	err := os.WriteFile(path, binary, 0644)
+
+	if err != nil {
+		fmt.Println(err)
+		fmt.Println("unexpected problem occurred")
+		os.Exit(1)
+	}
+}
+
+func doWriteFiles(directory string, files map[string][]byte) {
+	if err := os.MkdirAll(directory, 0755); err != nil {
+		fmt.Println(err)
+		fmt.Println("unexpected problem occurred")
+		os.Exit(1)
+	}
+
+	for filename, binary := range files {
+		doWriteFile(filepath.Join(directory, filename), binary)
+	}
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/raitonbl/ant/internal/commands/diff"
+	"github.com/thatisuday/commando"
+	"os"
+)
+
+func AddDiffCommand(registry *commando.CommandRegistry) *commando.Command {
+	return registry.Register("diff").
+		SetShortDescription("compares two versions of a CLI specification file").
+		SetDescription("compares two versions of a CLI specification file, failing when a change is breaking or the version isn't bumped accordingly").
+		AddArgument("old", "the CLI specification file URI of the previous version", "").
+		AddArgument("new", "the CLI specification file URI of the next version", "").
+		AddFlag("format,f", "format of the changes\ntext | json", commando.String, string(diff.Text)).
+		SetAction(doDiff)
+}
+
+func doDiff(args map[string]commando.ArgValue, flags map[string]commando.FlagValue) {
+	value, _ := flags["format"].GetString()
+	format := diff.Format(value)
+
+	result, err := diff.Diff(getValidContext(args["old"].Value), getValidContext(args["new"].Value))
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	binary, err := diff.Report(format, result)
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if format == diff.Text {
+		fmt.Print(string(binary))
+	} else {
+		fmt.Println(string(binary))
+	}
+
+	if result.IsBreaking() {
+		os.Exit(3)
+	}
+
+	if !result.Version.Valid {
+		os.Exit(4)
+	}
+}
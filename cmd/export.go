@@ -2,26 +2,38 @@ package cmd
 
 import (
 	"fmt"
+	"github.com/raitonbl/ant/internal/commands/export"
 	"github.com/raitonbl/ant/pkg/resources"
 	"github.com/thatisuday/commando"
 	"os"
+	"strings"
 )
 
 func AddExportCommand(registry *commando.CommandRegistry) *commando.Command {
 	return registry.Register("export").
-		SetShortDescription("retrieves the schema used during linting").
-		SetDescription("retrieves the JSON schema used during linting").
-		AddArgument("object", "object which export is intended\nschema - JSON schema for CLI definition", "schema").
-		AddArgument("file", "file which will contain the exported object", "schema.json").
-		SetAction(export)
+		SetShortDescription("exports an ant object into a file").
+		SetDescription("exports the JSON schema used during linting or a document that describes a CLI specification file").
+		AddArgument("object", "object which export is intended\nschema - JSON schema for CLI definition\nhtml - HTML document that describes the CLI\ncompletion-bash, completion-zsh, completion-fish, completion-powershell - shell completion script of the CLI\nman - man pages of the CLI, where file is the directory which contains them\nmarkdown - Markdown document that describes the CLI, where file is a directory when split", "schema").
+		AddArgument("file", "file which will contain the exported object\nauto - schema.json, index.html, completion.bash (.zsh, .fish, .ps1), man, README.md or docs when split", auto).
+		AddFlag("spec,s", "the CLI specification file URI", commando.String, "index.json").
+		AddFlag("split", "exports a Markdown document for each command into the directory", commando.Bool, nil).
+		SetAction(doExport)
 }
 
-func export(args map[string]commando.ArgValue, flags map[string]commando.FlagValue) {
+func doExport(args map[string]commando.ArgValue, flags map[string]commando.FlagValue) {
 	objectType := args["object"].Value
 
 	switch objectType {
 	case "schema":
 		doExportSchema(args, flags)
+	case "html":
+		doExportHtml(args, flags)
+	case "man":
+		doExportMan(args, flags)
+	case "markdown":
+		doExportMarkdown(args, flags)
+	case "completion-bash", "completion-zsh", "completion-fish", "completion-powershell":
+		doExportCompletion(export.Shell(strings.TrimPrefix(objectType, "completion-")), args, flags)
 	default:
 		fmt.Println(fmt.Sprintf("Fail: Unknown object %s", objectType))
 		os.Exit(1)
@@ -29,7 +41,7 @@ func export(args map[string]commando.ArgValue, flags map[string]commando.FlagVal
 }
 
 func doExportSchema(args map[string]commando.ArgValue, _ map[string]commando.FlagValue) {
-	path := args["file"].Value
+	path := getFile(args, "schema.json")
 
 	binary, _ := resources.GetResource("schema.json")
 
@@ -43,3 +55,95 @@ func doExportSchema(args map[string]commando.ArgValue, _ map[string]commando.Fla
 	}
 
 }
+
+func doExportHtml(args map[string]commando.ArgValue, flags map[string]commando.FlagValue) {
+	uri, _ := flags["spec"].GetString()
+	ctx := getValidContext(uri)
+
+	binary, err := export.Html(ctx)
+
+	if err != nil {
+		fmt.Println(err)
+		fmt.Println("unexpected problem occurred")
+		os.Exit(1)
+	}
+
+	doWriteFile(getFile(args, "index.html"), binary)
+}
+
+func doExportCompletion(shell export.Shell, args map[string]commando.ArgValue, flags map[string]commando.FlagValue) {
+	uri, _ := flags["spec"].GetString()
+	ctx := getValidContext(uri)
+
+	binary, err := export.Completion(ctx, shell)
+
+	if err != nil {
+		fmt.Println(err)
+		fmt.Println("unexpected problem occurred")
+		os.Exit(1)
+	}
+
+	doWriteFile(getFile(args, getCompletionFile(shell)), binary)
+}
+
+func doExportMan(args map[string]commando.ArgValue, flags map[string]commando.FlagValue) {
+	uri, _ := flags["spec"].GetString()
+	ctx := getValidContext(uri)
+
+	files, err := export.Man(ctx)
+
+	if err != nil {
+		fmt.Println(err)
+		fmt.Println("unexpected problem occurred")
+		os.Exit(1)
+	}
+
+	doWriteFiles(getFile(args, "man"), files)
+}
+
+func doExportMarkdown(args map[string]commando.ArgValue, flags map[string]commando.FlagValue) {
+	uri, _ := flags["spec"].GetString()
+	ctx := getValidContext(uri)
+
+	if split, _ := flags["split"].GetBool(); split {
+		files, err := export.MarkdownFiles(ctx)
+
+		if err != nil {
+			fmt.Println(err)
+			fmt.Println("unexpected problem occurred")
+			os.Exit(1)
+		}
+
+		doWriteFiles(getFile(args, "docs"), files)
+		return
+	}
+
+	binary, err := export.Markdown(ctx)
+
+	if err != nil {
+		fmt.Println(err)
+		fmt.Println("unexpected problem occurred")
+		os.Exit(1)
+	}
+
+	doWriteFile(getFile(args, export.MarkdownIndex), binary)
+}
+
+// getFile returns the file which the object is exported into, which is the default file of the object unless the argument is given
+func getFile(args map[string]commando.ArgValue, defaultFile string) string {
+
+	if value := args["file"].Value; value != auto {
+		return value
+	}
+
+	return defaultFile
+}
+
+func getCompletionFile(shell export.Shell) string {
+
+	if shell == export.PowerShell {
+		return "completion.ps1"
+	}
+
+	return "completion." + string(shell)
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/raitonbl/ant/internal/commands/generate"
+	"github.com/thatisuday/commando"
+	"os"
+)
+
+func AddGenerateCommand(registry *commando.CommandRegistry) *commando.Command {
+	return registry.Register("generate").
+		SetShortDescription("generates a project from a CLI specification file").
+		SetDescription("generates a project from a valid CLI specification file").
+		AddArgument("object", "object which generation is intended\ngo - Go CLI project\ntests - Go integration test project", "go").
+		AddArgument("file", "the CLI specification file URI", "index.json").
+		AddArgument("target", "go - directory where the project will be generated\ntests - binary which will be tested", ".").
+		AddFlag("output,o", "directory where the integration test project will be generated", commando.String, ".").
+		SetAction(doGenerate)
+}
+
+func doGenerate(args map[string]commando.ArgValue, flags map[string]commando.FlagValue) {
+	objectType := args["object"].Value
+
+	switch objectType {
+	case "go":
+		doGenerateGolang(args, flags)
+	case "tests":
+		doGenerateTests(args, flags)
+	default:
+		fmt.Println(fmt.Sprintf("Fail: Unknown object %s", objectType))
+		os.Exit(1)
+	}
+}
+
+func doGenerateGolang(args map[string]commando.ArgValue, _ map[string]commando.FlagValue) {
+	ctx := getValidContext(args["file"].Value)
+
+	err := generate.Golang(ctx, args["target"].Value)
+
+	if err != nil {
+		fmt.Println(err)
+		fmt.Println("unexpected problem occurred")
+		os.Exit(1)
+	}
+
+	fmt.Println("Project generated")
+}
+
+func doGenerateTests(args map[string]commando.ArgValue, flags map[string]commando.FlagValue) {
+	ctx := getValidContext(args["file"].Value)
+	directory, _ := flags["output"].GetString()
+
+	err := generate.Tests(ctx, args["target"].Value, directory)
+
+	if err != nil {
+		fmt.Println(err)
+		fmt.Println("unexpected problem occurred")
+		os.Exit(1)
+	}
+
+	fmt.Println("Project generated")
+}
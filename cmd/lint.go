@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/raitonbl/ant/internal"
 	"github.com/raitonbl/ant/internal/commands/lint"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_rule"
 	"github.com/thatisuday/commando"
 	"os"
 )
@@ -13,11 +14,24 @@ func AddLintCommand(registry *commando.CommandRegistry) *commando.Command {
 		SetShortDescription("validate a specific CLI specification file").
 		SetDescription("allows the validation of an CLI specification file").
 		AddArgument("file", "the CLI specification file URI", "index.json").
+		AddFlag("format,f", "format of the violations\ntext | json | sarif | junit | checkstyle", commando.String, string(lint.Text)).
+		AddFlag("config,c", "the lint configuration file URI\nauto - the .antlint.yaml next to the CLI specification file, if any", commando.String, auto).
+		AddFlag("strict", "fails when a warning is found", commando.Bool, nil).
+		AddFlag("list-rules", "lists the rules applied during linting", commando.Bool, nil).
 		SetAction(doLint)
 }
 
-func doLint(args map[string]commando.ArgValue, _ map[string]commando.FlagValue) {
+func doLint(args map[string]commando.ArgValue, flags map[string]commando.FlagValue) {
+
+	if listRules, _ := flags["list-rules"].GetBool(); listRules {
+		doListRules()
+		return
+	}
+
 	uri := args["file"].Value
+	strict, _ := flags["strict"].GetBool()
+	value, _ := flags["format"].GetString()
+	format := lint.Format(value)
 	ctx, err := internal.GetContext(uri)
 
 	if err != nil {
@@ -25,24 +39,62 @@ func doLint(args map[string]commando.ArgValue, _ map[string]commando.FlagValue)
 		os.Exit(1)
 	}
 
-	problems, err := lint.Lint(ctx)
+	configuration, err := getConfiguration(uri, flags)
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	problems, err := lint.LintWith(ctx, configuration)
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	binary, err := lint.Report(format, uri, problems)
 
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
 
-	if problems != nil && len(problems) > 0 {
+	if format != lint.Text {
+		fmt.Println(string(binary))
+	}
+
+	if format == lint.Text {
+		fmt.Print(string(binary))
+	}
 
-		txt := ""
-		for index, each := range problems {
-			txt += fmt.Sprintf("%d.path:%s\n message:%s", index, each.Path, each.Message)
+	if lint.IsFailure(problems, strict) {
+
+		if format == lint.Text {
+			fmt.Println("Document isn't valid")
 		}
 
-		fmt.Println(txt)
-		fmt.Println("Document isn't valid")
 		os.Exit(2)
 	}
 
-	fmt.Println("Document is valid")
+	if format == lint.Text {
+		fmt.Println("Document is valid")
+	}
+}
+
+// getConfiguration reads the configuration from the config flag, where auto means the one next to the specification
+func getConfiguration(uri string, flags map[string]commando.FlagValue) (*lint.Configuration, error) {
+	filename, _ := flags["config"].GetString()
+
+	if filename == auto {
+		filename = ""
+	}
+
+	return lint.GetConfiguration(uri, filename)
+}
+
+func doListRules() {
+	for _, each := range lint_rule.GetRules() {
+		fmt.Println(fmt.Sprintf("%s %-34s %-8s %s", each.Id, each.Name, each.Severity, each.Message))
+	}
 }
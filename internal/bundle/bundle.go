@@ -0,0 +1,376 @@
+package bundle
+
+import (
+	"fmt"
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/pkg/project"
+	"path"
+)
+
+type BundleContext struct {
+	document *project.Specification
+	hoist    bool
+	// hoisted maps each external reference into the id it was given within the bundle
+	hoisted map[string]string
+}
+
+// Bundle produces a single document out of a specification spread across files. Every refers-to is inlined,
+// unless hoist is set, in which case the referenced definitions are kept under the top-level sections
+func Bundle(ctx internal.ProjectContext, hoist bool) (*project.Specification, error) {
+
+	document, err := internal.GetDocument(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bundleContext := &BundleContext{document: document, hoist: hoist, hoisted: make(map[string]string)}
+	object := &project.Specification{}
+	*object = *document
+	object.Subcommands, object.Parameters, object.Exit, object.Schemas, object.Imports = nil, nil, nil, nil, nil
+
+	if hoist {
+		err = doHoistSections(bundleContext, object)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range document.Subcommands {
+		command, err := doBundleCommand(bundleContext, object, &document.Subcommands[index])
+
+		if err != nil {
+			return nil, err
+		}
+
+		object.Subcommands = append(object.Subcommands, *command)
+	}
+
+	return object, nil
+}
+
+func doHoistSections(ctx *BundleContext, object *project.Specification) error {
+
+	for _, each := range ctx.document.Schemas {
+		schema := *each
+		items, err := doBundleSchema(ctx, object, schema.Items, nil)
+
+		if err != nil {
+			return err
+		}
+
+		schema.Items = items
+		object.Schemas = append(object.Schemas, &schema)
+	}
+
+	for _, each := range ctx.document.Parameters {
+		param := each.Clone()
+		schema, err := doBundleSchema(ctx, object, param.Schema, nil)
+
+		if err != nil {
+			return err
+		}
+
+		param.Schema = schema
+		object.Parameters = append(object.Parameters, *param)
+	}
+
+	object.Exit = append(object.Exit, ctx.document.Exit...)
+
+	return nil
+}
+
+func doBundleCommand(ctx *BundleContext, object *project.Specification, instance *project.Command) (*project.Command, error) {
+	command := *instance
+	command.Parameters, command.Exit, command.Subcommands = nil, nil, nil
+
+	for _, each := range instance.Parameters {
+		param, err := doBundleParameter(ctx, object, each)
+
+		if err != nil {
+			return nil, err
+		}
+
+		command.Parameters = append(command.Parameters, *param)
+	}
+
+	for _, each := range instance.Exit {
+		exit, err := doBundleExit(ctx, object, each)
+
+		if err != nil {
+			return nil, err
+		}
+
+		command.Exit = append(command.Exit, *exit)
+	}
+
+	for _, each := range instance.Subcommands {
+		subcommand, err := doBundleCommand(ctx, object, each)
+
+		if err != nil {
+			return nil, err
+		}
+
+		command.Subcommands = append(command.Subcommands, subcommand)
+	}
+
+	return &command, nil
+}
+
+func doBundleParameter(ctx *BundleContext, object *project.Specification, instance project.Parameter) (*project.Parameter, error) {
+
+	if instance.RefersTo == nil {
+		param := instance.Clone()
+		schema, err := doBundleSchema(ctx, object, param.Schema, nil)
+
+		if err != nil {
+			return nil, err
+		}
+
+		param.Schema = schema
+
+		return param, nil
+	}
+
+	if ctx.hoist && !project.IsExternalReference(*instance.RefersTo) {
+		return instance.Clone(), nil
+	}
+
+	fromDocument := ctx.document.GetParameter(*instance.RefersTo)
+
+	if fromDocument == nil {
+		return nil, internal.GetProblemFactory().GetUnresolvableReference(*instance.RefersTo)
+	}
+
+	if ctx.hoist {
+		id, err := doHoistParameter(ctx, object, *instance.RefersTo, fromDocument)
+
+		if err != nil {
+			return nil, err
+		}
+
+		param := instance.Clone()
+		param.RefersTo = &id
+
+		return param, nil
+	}
+
+	param := fromDocument.Clone()
+
+	if instance.Index != nil {
+		param.Index = instance.Index
+	}
+
+	schema, err := doBundleSchema(ctx, object, param.Schema, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	param.Schema = schema
+	param.Extensions = getExtensions(fromDocument.Extensions, instance.Extensions)
+
+	return param, nil
+}
+
+func doBundleExit(ctx *BundleContext, object *project.Specification, instance project.Exit) (*project.Exit, error) {
+
+	if instance.RefersTo == nil || (ctx.hoist && !project.IsExternalReference(*instance.RefersTo)) {
+		return &instance, nil
+	}
+
+	fromDocument := ctx.document.GetExit(*instance.RefersTo)
+
+	if fromDocument == nil {
+		return nil, internal.GetProblemFactory().GetUnresolvableReference(*instance.RefersTo)
+	}
+
+	if !ctx.hoist {
+		exit := *fromDocument
+		exit.Id = nil
+		exit.Extensions = getExtensions(fromDocument.Extensions, instance.Extensions)
+		return &exit, nil
+	}
+
+	key := getKey(*instance.RefersTo)
+	id, isHoisted := ctx.hoisted[key]
+
+	if !isHoisted {
+		exit := *fromDocument
+		id = getId(fromDocument.Id, func(value string) bool { return object.GetExit(value) != nil })
+		exit.Id = &id
+		object.Exit = append(object.Exit, exit)
+		ctx.hoisted[key] = id
+	}
+
+	exit := instance
+	exit.RefersTo = &id
+
+	return &exit, nil
+}
+
+func doHoistParameter(ctx *BundleContext, object *project.Specification, value string, fromDocument *project.Parameter) (string, error) {
+	key := getKey(value)
+
+	if id, isHoisted := ctx.hoisted[key]; isHoisted {
+		return id, nil
+	}
+
+	param := fromDocument.Clone()
+	id := getId(fromDocument.Id, func(value string) bool { return object.GetParameter(value) != nil })
+	param.Id = &id
+	ctx.hoisted[key] = id
+
+	schema, err := doBundleSchema(ctx, object, param.Schema, nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	param.Schema = schema
+	object.Parameters = append(object.Parameters, *param)
+
+	return id, nil
+}
+
+// doBundleSchema resolves the schema, keeping track of the references being resolved so that circular references are detected
+func doBundleSchema(ctx *BundleContext, object *project.Specification, instance *project.Schema, visited []string) (*project.Schema, error) {
+
+	if instance == nil {
+		return nil, nil
+	}
+
+	if instance.RefersTo == nil {
+		schema := *instance
+		items, err := doBundleSchema(ctx, object, instance.Items, visited)
+
+		if err != nil {
+			return nil, err
+		}
+
+		schema.Items = items
+
+		return &schema, nil
+	}
+
+	value := *instance.RefersTo
+
+	if ctx.hoist && !project.IsExternalReference(value) {
+		return instance, nil
+	}
+
+	key := getKey(value)
+
+	for _, each := range visited {
+		if each == key {
+			return nil, internal.GetProblemFactory().GetUnresolvableReference(value)
+		}
+	}
+
+	fromDocument := ctx.document.GetSchema(value)
+
+	if fromDocument == nil {
+		return nil, internal.GetProblemFactory().GetUnresolvableReference(value)
+	}
+
+	if ctx.hoist {
+		id, err := doHoistSchema(ctx, object, key, fromDocument, append(visited, key))
+
+		if err != nil {
+			return nil, err
+		}
+
+		schema := *instance
+		schema.RefersTo = &id
+
+		return &schema, nil
+	}
+
+	schema, err := doBundleSchema(ctx, object, fromDocument, append(visited, key))
+
+	if err != nil {
+		return nil, err
+	}
+
+	schema.Id = nil
+	schema.Extensions = getExtensions(fromDocument.Extensions, instance.Extensions)
+
+	return schema, nil
+}
+
+func doHoistSchema(ctx *BundleContext, object *project.Specification, key string, fromDocument *project.Schema, visited []string) (string, error) {
+
+	if id, isHoisted := ctx.hoisted[key]; isHoisted {
+		return id, nil
+	}
+
+	schema := *fromDocument
+	id := getId(fromDocument.Id, func(value string) bool { return object.GetSchema(value) != nil })
+	schema.Id = &id
+	ctx.hoisted[key] = id
+
+	items, err := doBundleSchema(ctx, object, fromDocument.Items, visited)
+
+	if err != nil {
+		return "", err
+	}
+
+	schema.Items = items
+	object.Schemas = append(object.Schemas, &schema)
+
+	return id, nil
+}
+
+// getKey normalizes the reference, so that the same definition is hoisted only once
+func getKey(value string) string {
+	reference := project.GetReference(value)
+
+	if reference == nil {
+		return value
+	}
+
+	if reference.IsExternal() {
+		reference.Filename = path.Clean(reference.Filename)
+	}
+
+	return reference.String()
+}
+
+// getId returns the id of the definition, suffixed whenever it is already taken within the bundle
+func getId(value *string, isTaken func(string) bool) string {
+	id := ""
+
+	if value != nil {
+		id = *value
+	}
+
+	if !isTaken(id) {
+		return id
+	}
+
+	for index := 2; ; index++ {
+		if candidate := fmt.Sprintf("%s-%d", id, index); !isTaken(candidate) {
+			return candidate
+		}
+	}
+}
+
+func getExtensions(fromDocument project.Extensions, fromReference project.Extensions) project.Extensions {
+
+	if len(fromReference) == 0 {
+		return fromDocument
+	}
+
+	extensions := make(project.Extensions)
+
+	for key, value := range fromDocument {
+		extensions[key] = value
+	}
+
+	for key, value := range fromReference {
+		extensions[key] = value
+	}
+
+	return extensions
+}
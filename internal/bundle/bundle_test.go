@@ -0,0 +1,136 @@
+package bundle
+
+import (
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/pkg/project"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBundle_where_refers_to_other_files(t *testing.T) {
+	document := doBundle(t, "../commands/lint/testdata/index-064.yaml", false)
+
+	assert.Nil(t, document.Parameters)
+	assert.Nil(t, document.Exit)
+	assert.Nil(t, document.Schemas)
+
+	lint := document.Subcommands[0]
+	assert.Equal(t, 3, len(lint.Parameters))
+	assert.Nil(t, lint.Parameters[1].RefersTo)
+	assert.Equal(t, "verbose", *lint.Parameters[1].Name)
+	assert.Nil(t, lint.Parameters[1].Schema.RefersTo)
+	assert.Nil(t, lint.Parameters[1].Schema.Id)
+	assert.Equal(t, []string{"quiet", "normal", "debug"}, lint.Parameters[1].Schema.Enum)
+	assert.Equal(t, []string{"text", "json"}, lint.Parameters[2].Schema.Enum)
+	assert.Equal(t, 2, *lint.Exit[0].Code)
+	assert.Nil(t, lint.Exit[0].Id)
+
+	build := document.Subcommands[1]
+	assert.Equal(t, 0, *build.Parameters[0].Index)
+	assert.Equal(t, []string{"text", "json"}, build.Parameters[1].Schema.Enum)
+}
+
+func TestBundle_where_hoist(t *testing.T) {
+	document := doBundle(t, "../commands/lint/testdata/index-064.yaml", true)
+
+	assert.Equal(t, []string{"filename", "verbose", "format"}, getParameterIds(document))
+	assert.Equal(t, "verbosity", *document.GetParameter("verbose").Schema.RefersTo)
+	assert.Equal(t, 2, len(document.Schemas))
+	assert.Equal(t, "file-not-found", *document.Exit[0].Id)
+
+	lint := document.Subcommands[0]
+	assert.Equal(t, "verbose", *lint.Parameters[1].RefersTo)
+	assert.Equal(t, "file-not-found", *lint.Exit[0].RefersTo)
+
+	build := document.Subcommands[1]
+	assert.Equal(t, "filename", *build.Parameters[0].RefersTo)
+	assert.Equal(t, 0, *build.Parameters[0].Index)
+	assert.Equal(t, "format", *build.Parameters[1].Schema.RefersTo)
+}
+
+func TestBundle_where_hoist_and_id_is_taken(t *testing.T) {
+	document := doBundle(t, "../commands/lint/testdata/index-066.yaml", true)
+
+	assert.Equal(t, []string{"filename", "verbose", "filename-2"}, getParameterIds(document))
+	assert.Equal(t, "filename", *document.Subcommands[0].Parameters[0].RefersTo)
+	assert.Equal(t, "verbose", *document.Subcommands[0].Parameters[1].RefersTo)
+	assert.Equal(t, "filename-2", *document.Subcommands[1].Parameters[0].RefersTo)
+	assert.Equal(t, "verbose", *document.Subcommands[1].Parameters[1].RefersTo)
+}
+
+func TestBundle_where_refers_to_is_unresolvable(t *testing.T) {
+	ctx, err := internal.GetContext("../commands/lint/testdata/index-065.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = Bundle(ctx, false); err == nil {
+		t.Fatal("error not caught")
+	}
+
+}
+
+func TestBundle_where_command_has_aliases_and_is_hidden(t *testing.T) {
+	document := doBundle(t, "../commands/lint/testdata/index-071.yaml", false)
+
+	build := document.Subcommands[0]
+	assert.Equal(t, []string{"b"}, build.Aliases)
+	assert.Equal(t, []string{"s"}, build.Subcommands[0].Aliases)
+	assert.True(t, build.Subcommands[1].IsHidden())
+	assert.True(t, document.Subcommands[1].IsHidden())
+}
+
+func TestBundle_where_deprecated(t *testing.T) {
+	document := doBundle(t, "../commands/lint/testdata/index-068.yaml", false)
+
+	build := document.Subcommands[0]
+	assert.True(t, build.IsDeprecated())
+	assert.Equal(t, "compile", *build.Deprecated.ReplacedBy)
+	assert.Equal(t, "target", *build.Parameters[0].Deprecated.ReplacedBy)
+	assert.True(t, build.Parameters[2].IsDeprecated())
+	assert.True(t, build.Exit[0].IsDeprecated())
+	assert.False(t, document.Subcommands[1].IsDeprecated())
+}
+
+func TestBundle_where_command_has_constraints(t *testing.T) {
+	document := doBundle(t, "../commands/lint/testdata/index-074.yaml", false)
+
+	export := document.Subcommands[0]
+	assert.Equal(t, [][]string{{"json", "yaml"}}, export.Constraints.Exclusive)
+	assert.Equal(t, [][]string{{"json", "yaml", "output"}}, export.Constraints.AtLeastOneOf)
+	assert.Equal(t, [][]string{{"user", "password", "user"}}, document.Subcommands[1].Constraints.RequiredTogether)
+}
+
+func TestBundle_where_env_prefix(t *testing.T) {
+	document := doBundle(t, "../commands/lint/testdata/index-073.yaml", false)
+
+	assert.Equal(t, "CLI_", *document.EnvPrefix)
+	assert.Equal(t, "CLI_CONFIG", document.GetEnv(&document.Subcommands[0].Parameters[0]))
+}
+
+func getParameterIds(document *project.Specification) []string {
+	array := make([]string, 0)
+
+	for _, each := range document.Parameters {
+		array = append(array, *each.Id)
+	}
+
+	return array
+}
+
+func doBundle(t *testing.T, filename string, hoist bool) *project.Specification {
+	ctx, err := internal.GetContext(filename)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	document, err := Bundle(ctx, hoist)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return document
+}
@@ -0,0 +1,70 @@
+package diff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// changelogSections are the change types listed by a changelog, in the order their sections are written
+var changelogSections = []ChangeType{Added, Changed, Deprecated, Removed}
+
+// Changelog returns a Markdown changelog section for the version of the new specification, which lists the changes by command path
+func Changelog(result *Result) []byte {
+	version := "Unreleased"
+
+	if result.Version != nil && result.Version.New != "" {
+		version = result.Version.New
+	}
+
+	txt := fmt.Sprintf("## [%s]\n", version)
+
+	for _, section := range changelogSections {
+		changes := getChanges(result.Changes, section)
+
+		if len(changes) == 0 {
+			continue
+		}
+
+		txt += fmt.Sprintf("\n### %s\n\n", getSectionTitle(section))
+		path := ""
+
+		for index, each := range changes {
+			if index == 0 || each.Path != path {
+				path = each.Path
+				txt += fmt.Sprintf("- `%s`\n", path)
+			}
+
+			if each.Breaking {
+				txt += fmt.Sprintf("  - **BREAKING** %s\n", each.Message)
+			} else {
+				txt += fmt.Sprintf("  - %s\n", each.Message)
+			}
+		}
+	}
+
+	return []byte(txt)
+}
+
+// getSectionTitle returns the title of the changelog section that lists the change type (e.g. Added)
+func getSectionTitle(changeType ChangeType) string {
+	title := string(changeType)
+	return strings.ToUpper(title[:1]) + title[1:]
+}
+
+// getChanges returns the changes of the type, sorted by command path
+func getChanges(array []Change, changeType ChangeType) []Change {
+	changes := make([]Change, 0)
+
+	for _, each := range array {
+		if each.Type == changeType {
+			changes = append(changes, each)
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return strings.Compare(changes[i].Path, changes[j].Path) < 0
+	})
+
+	return changes
+}
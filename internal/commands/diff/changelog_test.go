@@ -0,0 +1,47 @@
+package diff
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestChangelog(t *testing.T) {
+	result := &Result{Version: &Version{Old: "1.2.0", New: "2.0.0"}, Changes: []Change{
+		{Type: Removed, Breaking: true, Object: CommandObject, Path: "build test", Message: "command 'build test' is removed"},
+		{Type: Added, Object: FlagObject, Path: "lint", Message: "flag '--strict' is added"},
+		{Type: Changed, Breaking: true, Object: FlagObject, Path: "lint", Message: "flag '--format' is renamed to '--output-format'"},
+		{Type: Added, Object: CommandObject, Path: "build image", Message: "command 'build image' is added"},
+		{Type: Added, Object: ExitObject, Path: "lint", Message: "exit 1 is added"},
+		{Type: Deprecated, Object: FlagObject, Path: "lint", Message: "flag '--verbose' is deprecated"},
+	}}
+
+	assert.Equal(t, `## [2.0.0]
+
+### Added
+
+- `+"`build image`"+`
+  - command 'build image' is added
+- `+"`lint`"+`
+  - flag '--strict' is added
+  - exit 1 is added
+
+### Changed
+
+- `+"`lint`"+`
+  - **BREAKING** flag '--format' is renamed to '--output-format'
+
+### Deprecated
+
+- `+"`lint`"+`
+  - flag '--verbose' is deprecated
+
+### Removed
+
+- `+"`build test`"+`
+  - **BREAKING** command 'build test' is removed
+`, string(Changelog(result)))
+}
+
+func TestChangelog_where_version_is_missing(t *testing.T) {
+	assert.Equal(t, "## [Unreleased]\n", string(Changelog(&Result{Version: &Version{}})))
+}
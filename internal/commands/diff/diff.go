@@ -0,0 +1,404 @@
+package diff
+
+import (
+	"fmt"
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/internal/utils"
+	"github.com/raitonbl/ant/pkg/project"
+	"sort"
+	"strings"
+)
+
+type ChangeType string
+
+const (
+	Added      ChangeType = "added"
+	Changed    ChangeType = "changed"
+	Deprecated ChangeType = "deprecated"
+	Removed    ChangeType = "removed"
+)
+
+type Object string
+
+const (
+	CommandObject  Object = "command"
+	ArgumentObject Object = "argument"
+	FlagObject     Object = "flag"
+	ExitObject     Object = "exit"
+)
+
+// Change is a difference between two versions of a specification, where Path is the path of the command it belongs to
+type Change struct {
+	Type     ChangeType `json:"type"`
+	Breaking bool       `json:"breaking"`
+	Object   Object     `json:"object"`
+	Path     string     `json:"path"`
+	Name     string     `json:"name"`
+	Message  string     `json:"message"`
+	// Widening tells whether the change accepts input that was rejected before, which is new functionality
+	Widening bool `json:"-"`
+}
+
+type Result struct {
+	Changes []Change `json:"changes"`
+	Version *Version `json:"version"`
+}
+
+// IsBreaking determines whether any of the changes is breaking
+func (instance *Result) IsBreaking() bool {
+	for _, each := range instance.Changes {
+		if each.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff compares the commands, arguments, flags and exit of both specifications, where parameters and exit are resolved
+func Diff(oldCtx internal.ProjectContext, newCtx internal.ProjectContext) (*Result, error) {
+
+	oldDocument, err := internal.GetDocument(oldCtx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	newDocument, err := internal.GetDocument(newCtx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffDocuments(oldDocument, newDocument), nil
+}
+
+func DiffDocuments(oldDocument *project.Specification, newDocument *project.Specification) *Result {
+	result := &Result{Changes: make([]Change, 0)}
+	oldCommands := getCommands(oldDocument.GetCommands(), make(map[string]*project.ResolvedCommand))
+	newCommands := getCommands(newDocument.GetCommands(), make(map[string]*project.ResolvedCommand))
+
+	for _, path := range getKeys(oldCommands) {
+		if newCommands[path] == nil {
+			result.Changes = append(result.Changes, Change{Type: Removed, Breaking: true, Object: CommandObject, Path: path, Name: path,
+				Message: fmt.Sprintf("command '%s' is removed", path)})
+		}
+	}
+
+	for _, path := range getKeys(newCommands) {
+		if oldCommands[path] == nil {
+			result.Changes = append(result.Changes, Change{Type: Added, Object: CommandObject, Path: path, Name: path,
+				Message: fmt.Sprintf("command '%s' is added", path)})
+			continue
+		}
+
+		result.Changes = append(result.Changes, doDiffCommand(oldCommands[path], newCommands[path])...)
+	}
+
+	result.Version = getVersion(utils.GetText(oldDocument.Version), utils.GetText(newDocument.Version), result.Changes)
+
+	return result
+}
+
+func doDiffCommand(oldCommand *project.ResolvedCommand, newCommand *project.ResolvedCommand) []Change {
+	path := strings.Join(newCommand.Path, " ")
+	changes := make([]Change, 0)
+
+	if !oldCommand.Command.IsDeprecated() && newCommand.Command.IsDeprecated() {
+		changes = append(changes, Change{Type: Deprecated, Object: CommandObject, Path: path, Name: path,
+			Message: fmt.Sprintf("command '%s' is deprecated", path)})
+	}
+
+	for _, each := range oldCommand.Command.Aliases {
+		if !contains(newCommand.Command.Aliases, each) {
+			changes = append(changes, Change{Type: Removed, Breaking: true, Object: CommandObject, Path: path, Name: path,
+				Message: fmt.Sprintf("alias '%s' of command '%s' is removed", each, path)})
+		}
+	}
+
+	for _, each := range newCommand.Command.Aliases {
+		if !contains(oldCommand.Command.Aliases, each) {
+			changes = append(changes, Change{Type: Added, Object: CommandObject, Path: path, Name: path,
+				Message: fmt.Sprintf("alias '%s' of command '%s' is added", each, path)})
+		}
+	}
+
+	changes = append(changes, doDiffArguments(path, oldCommand.GetArguments(), newCommand.GetArguments())...)
+	changes = append(changes, doDiffFlags(path, oldCommand.GetFlags(), newCommand.GetFlags())...)
+	changes = append(changes, doDiffExit(path, oldCommand.Exit, newCommand.Exit)...)
+
+	return changes
+}
+
+func doDiffArguments(path string, oldArguments []*project.ResolvedParameter, newArguments []*project.ResolvedParameter) []Change {
+	changes := make([]Change, 0)
+
+	for _, each := range oldArguments {
+		if getParameter(newArguments, each.Parameter.GetName()) == nil {
+			changes = append(changes, newParameterChange(Removed, true, path, each.Parameter, "is removed"))
+		}
+	}
+
+	for _, each := range newArguments {
+		param := each.Parameter
+		fromOld := getParameter(oldArguments, param.GetName())
+
+		if fromOld == nil {
+			changes = append(changes, newParameterChange(Added, param.IsRequired(), path, param, getAddedMessage(param)))
+			continue
+		}
+
+		if fromOld.Parameter.GetIndex() != param.GetIndex() {
+			changes = append(changes, newParameterChange(Changed, true, path, param,
+				fmt.Sprintf("index changed from %d to %d", fromOld.Parameter.GetIndex(), param.GetIndex())))
+		}
+
+		changes = append(changes, doDiffParameter(path, fromOld.Parameter, param)...)
+	}
+
+	return changes
+}
+
+func doDiffFlags(path string, oldFlags []*project.ResolvedParameter, newFlags []*project.ResolvedParameter) []Change {
+	changes := make([]Change, 0)
+
+	for _, each := range oldFlags {
+		param := each.Parameter
+
+		if getParameter(newFlags, param.GetName()) != nil {
+			continue
+		}
+
+		if renamed := getRenamedFlag(oldFlags, newFlags, param); renamed != nil {
+			changes = append(changes, newParameterChange(Changed, true, path, param, fmt.Sprintf("is renamed to '--%s'", renamed.GetName())))
+		} else {
+			changes = append(changes, newParameterChange(Removed, true, path, param, "is removed"))
+		}
+	}
+
+	for _, each := range newFlags {
+		param := each.Parameter
+		fromOld := getParameter(oldFlags, param.GetName())
+
+		if fromOld == nil && getRenamedFlag(newFlags, oldFlags, param) == nil {
+			changes = append(changes, newParameterChange(Added, param.IsRequired(), path, param, getAddedMessage(param)))
+		}
+
+		if fromOld == nil {
+			continue
+		}
+
+		oldShortForm, newShortForm := utils.GetText(fromOld.Parameter.ShortForm), utils.GetText(param.ShortForm)
+
+		if oldShortForm != "" && oldShortForm != newShortForm {
+			changes = append(changes, newParameterChange(Changed, true, path, param, fmt.Sprintf("short form '-%s' is removed", oldShortForm)))
+		}
+
+		if oldShortForm == "" && newShortForm != "" {
+			changes = append(changes, newParameterChange(Changed, false, path, param, fmt.Sprintf("short form '-%s' is added", newShortForm)))
+		}
+
+		changes = append(changes, doDiffParameter(path, fromOld.Parameter, param)...)
+	}
+
+	return changes
+}
+
+func doDiffParameter(path string, oldParameter *project.Parameter, newParameter *project.Parameter) []Change {
+	changes := make([]Change, 0)
+
+	if !oldParameter.IsRequired() && newParameter.IsRequired() {
+		changes = append(changes, newParameterChange(Changed, true, path, newParameter, "is now required"))
+	}
+
+	if oldParameter.IsRequired() && !newParameter.IsRequired() {
+		changes = append(changes, newWideningChange(path, newParameter, "is now optional"))
+	}
+
+	if !oldParameter.IsDeprecated() && newParameter.IsDeprecated() {
+		changes = append(changes, newParameterChange(Deprecated, false, path, newParameter, "is deprecated"))
+	}
+
+	oldDefault, newDefault := utils.GetText(oldParameter.DefaultValue), utils.GetText(newParameter.DefaultValue)
+
+	if oldDefault != "" && newDefault == "" {
+		changes = append(changes, newParameterChange(Changed, true, path, newParameter, fmt.Sprintf("default '%s' is removed", oldDefault)))
+	}
+
+	if oldDefault == "" && newDefault != "" {
+		changes = append(changes, newParameterChange(Changed, false, path, newParameter, fmt.Sprintf("default '%s' is added", newDefault)))
+	}
+
+	if oldDefault != "" && newDefault != "" && oldDefault != newDefault {
+		changes = append(changes, newParameterChange(Changed, false, path, newParameter,
+			fmt.Sprintf("default changed from '%s' to '%s'", oldDefault, newDefault)))
+	}
+
+	tightened, loosened := doDiffSchema(oldParameter.Schema, newParameter.Schema)
+
+	if len(tightened) > 0 {
+		changes = append(changes, newParameterChange(Changed, true, path, newParameter, "schema is tightened: "+strings.Join(tightened, ", ")))
+	}
+
+	if len(loosened) > 0 {
+		changes = append(changes, newWideningChange(path, newParameter, "schema is loosened: "+strings.Join(loosened, ", ")))
+	}
+
+	return changes
+}
+
+func doDiffExit(path string, oldExit []*project.ResolvedExit, newExit []*project.ResolvedExit) []Change {
+	changes := make([]Change, 0)
+
+	for _, each := range oldExit {
+		fromNew := getExit(newExit, each.Exit)
+
+		if fromNew != nil && getCode(fromNew) != getCode(each.Exit) {
+			changes = append(changes, newExitChange(Changed, true, path, fromNew,
+				fmt.Sprintf("exit '%s' changed its code from %s to %s", getExitName(each.Exit), getCode(each.Exit), getCode(fromNew))))
+		}
+
+		if fromNew != nil && !each.Exit.IsDeprecated() && fromNew.IsDeprecated() {
+			changes = append(changes, newExitChange(Deprecated, false, path, fromNew, fmt.Sprintf("exit %s is deprecated", getCode(fromNew))))
+		}
+
+		if fromNew == nil && getExitByCode(newExit, getCode(each.Exit)) == nil {
+			changes = append(changes, newExitChange(Removed, false, path, each.Exit, fmt.Sprintf("exit %s is removed", getCode(each.Exit))))
+		}
+	}
+
+	for _, each := range newExit {
+		if getExit(oldExit, each.Exit) == nil && getExitByCode(oldExit, getCode(each.Exit)) == nil {
+			changes = append(changes, newExitChange(Added, false, path, each.Exit, fmt.Sprintf("exit %s is added", getCode(each.Exit))))
+		}
+	}
+
+	return changes
+}
+
+func newParameterChange(changeType ChangeType, breaking bool, path string, param *project.Parameter, message string) Change {
+	return Change{Type: changeType, Breaking: breaking, Object: getObject(param), Path: path, Name: getParameterName(param),
+		Message: fmt.Sprintf("%s '%s' %s", getObject(param), getParameterName(param), message)}
+}
+
+func newWideningChange(path string, param *project.Parameter, message string) Change {
+	change := newParameterChange(Changed, false, path, param, message)
+	change.Widening = true
+	return change
+}
+
+func newExitChange(changeType ChangeType, breaking bool, path string, exit *project.Exit, message string) Change {
+	return Change{Type: changeType, Breaking: breaking, Object: ExitObject, Path: path, Name: getCode(exit), Message: message}
+}
+
+func getAddedMessage(param *project.Parameter) string {
+
+	if param.IsRequired() {
+		return "is added as required"
+	}
+
+	return "is added"
+}
+
+// getRenamedFlag returns the flag of the other version which replaces the flag, meaning it has the same short form but a different name
+func getRenamedFlag(array []*project.ResolvedParameter, other []*project.ResolvedParameter, param *project.Parameter) *project.Parameter {
+
+	if param.ShortForm == nil {
+		return nil
+	}
+
+	for _, each := range other {
+		if each.Parameter.ShortForm != nil && *each.Parameter.ShortForm == *param.ShortForm && getParameter(array, each.Parameter.GetName()) == nil {
+			return each.Parameter
+		}
+	}
+
+	return nil
+}
+
+func getCommands(array []*project.ResolvedCommand, cache map[string]*project.ResolvedCommand) map[string]*project.ResolvedCommand {
+	for _, each := range array {
+		cache[strings.Join(each.Path, " ")] = each
+		getCommands(each.Subcommands, cache)
+	}
+	return cache
+}
+
+func getKeys(cache map[string]*project.ResolvedCommand) []string {
+	keys := make([]string, 0, len(cache))
+
+	for key := range cache {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func getParameter(array []*project.ResolvedParameter, name string) *project.ResolvedParameter {
+	for _, each := range array {
+		if each.Parameter.GetName() == name {
+			return each
+		}
+	}
+	return nil
+}
+
+// getExit returns the exit which matches the exit by id or, when there's no id, by message
+func getExit(array []*project.ResolvedExit, exit *project.Exit) *project.Exit {
+	for _, each := range array {
+		if exit.Id != nil && each.Exit.Id != nil && *each.Exit.Id == *exit.Id {
+			return each.Exit
+		}
+
+		if exit.Id == nil && exit.Message != nil && each.Exit.Message != nil && *each.Exit.Message == *exit.Message {
+			return each.Exit
+		}
+	}
+	return nil
+}
+
+func getExitByCode(array []*project.ResolvedExit, code string) *project.Exit {
+	for _, each := range array {
+		if getCode(each.Exit) == code {
+			return each.Exit
+		}
+	}
+	return nil
+}
+
+func getExitName(exit *project.Exit) string {
+
+	if exit.Id != nil {
+		return *exit.Id
+	}
+
+	return utils.GetText(exit.Message)
+}
+
+func getCode(exit *project.Exit) string {
+
+	if exit.Code == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", *exit.Code)
+}
+
+func getObject(param *project.Parameter) Object {
+
+	if param.IsArgument() {
+		return ArgumentObject
+	}
+
+	return FlagObject
+}
+
+func getParameterName(param *project.Parameter) string {
+
+	if param.IsArgument() {
+		return param.GetName()
+	}
+
+	return "--" + param.GetName()
+}
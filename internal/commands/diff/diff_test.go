@@ -0,0 +1,287 @@
+package diff
+
+import (
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/pkg/project"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const oldDocument = `
+name: cli
+version: 1.2.0
+commands:
+  - name: lint
+    parameters:
+      - in: arguments
+        name: file
+        index: 0
+      - in: flags
+        name: format
+        short-form: f
+        schema:
+          type: string
+          enum: [text, json]
+    exit:
+      - code: 0
+        message: document is valid
+      - code: 2
+        message: document isn't valid
+  - name: build
+    commands:
+      - name: stack
+        parameters:
+          - in: flags
+            name: language
+            schema:
+              type: string
+      - name: test
+`
+
+func TestDiff_where_nothing_changes(t *testing.T) {
+	result := doDiffTest(t, oldDocument, oldDocument)
+
+	assert.Empty(t, result.Changes)
+	assert.False(t, result.IsBreaking())
+	assert.Equal(t, &Version{Old: "1.2.0", New: "1.2.0", Required: None, Actual: None, Valid: true}, result.Version)
+}
+
+func TestDiff_where_changes_are_breaking(t *testing.T) {
+	result := doDiffTest(t, oldDocument, `
+name: cli
+version: 1.3.0
+commands:
+  - name: lint
+    parameters:
+      - in: arguments
+        name: file
+        index: 1
+      - in: arguments
+        name: directory
+        index: 0
+      - in: flags
+        name: output-format
+        short-form: f
+        schema:
+          type: string
+          enum: [text]
+    exit:
+      - code: 0
+        message: document is valid
+      - code: 3
+        message: document isn't valid
+  - name: build
+    commands:
+      - name: stack
+        parameters:
+          - in: flags
+            name: language
+            schema:
+              type: string
+              min-length: 2
+`)
+
+	assert.True(t, result.IsBreaking())
+	assert.Equal(t, []Change{
+		{Type: Removed, Breaking: true, Object: CommandObject, Path: "build test", Name: "build test", Message: "command 'build test' is removed"},
+		{Type: Changed, Breaking: true, Object: FlagObject, Path: "build stack", Name: "--language", Message: "flag '--language' schema is tightened: min-length changed to 2"},
+		{Type: Added, Breaking: true, Object: ArgumentObject, Path: "lint", Name: "directory", Message: "argument 'directory' is added as required"},
+		{Type: Changed, Breaking: true, Object: ArgumentObject, Path: "lint", Name: "file", Message: "argument 'file' index changed from 0 to 1"},
+		{Type: Changed, Breaking: true, Object: FlagObject, Path: "lint", Name: "--format", Message: "flag '--format' is renamed to '--output-format'"},
+		{Type: Changed, Breaking: true, Object: ExitObject, Path: "lint", Name: "3", Message: "exit 'document isn't valid' changed its code from 2 to 3"},
+	}, result.Changes)
+	assert.Equal(t, Major, result.Version.Required)
+	assert.Equal(t, Minor, result.Version.Actual)
+	assert.False(t, result.Version.Valid)
+	assert.Equal(t, "a major bump is required, but version 1.3.0 is a minor bump of 1.2.0", result.Version.Message)
+}
+
+func TestDiff_where_changes_arent_breaking(t *testing.T) {
+	result := doDiffTest(t, oldDocument, `
+name: cli
+version: 1.3.0
+commands:
+  - name: lint
+    parameters:
+      - in: arguments
+        name: file
+        index: 0
+      - in: flags
+        name: format
+        short-form: f
+        schema:
+          type: string
+          enum: [text, json, sarif]
+      - in: flags
+        name: strict
+        schema:
+          type: boolean
+    exit:
+      - code: 0
+        message: document is valid
+      - code: 2
+        message: document isn't valid
+      - code: 1
+        message: unexpected problem occurred
+  - name: build
+    commands:
+      - name: stack
+        parameters:
+          - in: flags
+            name: language
+            schema:
+              type: string
+      - name: test
+      - name: image
+`)
+
+	assert.False(t, result.IsBreaking())
+	assert.Equal(t, []Change{
+		{Type: Added, Object: CommandObject, Path: "build image", Name: "build image", Message: "command 'build image' is added"},
+		{Type: Changed, Object: FlagObject, Path: "lint", Name: "--format", Message: "flag '--format' schema is loosened: enum value sarif is added", Widening: true},
+		{Type: Added, Object: FlagObject, Path: "lint", Name: "--strict", Message: "flag '--strict' is added"},
+		{Type: Added, Object: ExitObject, Path: "lint", Name: "1", Message: "exit 1 is added"},
+	}, result.Changes)
+	assert.Equal(t, &Version{Old: "1.2.0", New: "1.3.0", Required: Minor, Actual: Minor, Valid: true}, result.Version)
+}
+
+func TestDiff_where_deprecated(t *testing.T) {
+	result := doDiffTest(t, oldDocument, `
+name: cli
+version: 1.3.0
+commands:
+  - name: lint
+    parameters:
+      - in: arguments
+        name: file
+        index: 0
+      - in: flags
+        name: format
+        short-form: f
+        deprecated:
+          since: 1.3.0
+        schema:
+          type: string
+          enum: [text, json]
+    exit:
+      - code: 0
+        message: document is valid
+      - code: 2
+        message: document isn't valid
+        deprecated: true
+  - name: build
+    deprecated: true
+    commands:
+      - name: stack
+        parameters:
+          - in: flags
+            name: language
+            schema:
+              type: string
+      - name: test
+`)
+
+	assert.False(t, result.IsBreaking())
+	assert.Equal(t, []Change{
+		{Type: Deprecated, Object: CommandObject, Path: "build", Name: "build", Message: "command 'build' is deprecated"},
+		{Type: Deprecated, Object: FlagObject, Path: "lint", Name: "--format", Message: "flag '--format' is deprecated"},
+		{Type: Deprecated, Object: ExitObject, Path: "lint", Name: "2", Message: "exit 2 is deprecated"},
+	}, result.Changes)
+	assert.Equal(t, &Version{Old: "1.2.0", New: "1.3.0", Required: Minor, Actual: Minor, Valid: true}, result.Version)
+}
+
+func TestDiff_where_aliases_change(t *testing.T) {
+	result := doDiffTest(t, `
+name: cli
+version: 1.2.0
+commands:
+  - name: lint
+    aliases: [l, check]
+`, `
+name: cli
+version: 1.3.0
+commands:
+  - name: lint
+    aliases: [check, validate]
+`)
+
+	assert.True(t, result.IsBreaking())
+	assert.Equal(t, []Change{
+		{Type: Removed, Breaking: true, Object: CommandObject, Path: "lint", Name: "lint", Message: "alias 'l' of command 'lint' is removed"},
+		{Type: Added, Object: CommandObject, Path: "lint", Name: "lint", Message: "alias 'validate' of command 'lint' is added"},
+	}, result.Changes)
+}
+
+func TestDiff_where_defaults_change(t *testing.T) {
+	result := doDiffTest(t, `
+name: cli
+version: 1.2.0
+commands:
+  - name: lint
+    parameters:
+      - in: flags
+        name: format
+        default: text
+      - in: flags
+        name: output
+`, `
+name: cli
+version: 1.2.1
+commands:
+  - name: lint
+    parameters:
+      - in: flags
+        name: format
+      - in: flags
+        name: output
+        default: report.txt
+`)
+
+	assert.True(t, result.IsBreaking())
+	assert.Equal(t, []Change{
+		{Type: Changed, Breaking: true, Object: FlagObject, Path: "lint", Name: "--format", Message: "flag '--format' default 'text' is removed"},
+		{Type: Changed, Object: FlagObject, Path: "lint", Name: "--output", Message: "flag '--output' default 'report.txt' is added"},
+	}, result.Changes)
+}
+
+func TestDiff_where_schema_is_tightened(t *testing.T) {
+	oldMaximum, newMaximum, pattern := 10, 5, "^a$"
+	tightened, loosened := doDiffSchema(&project.Schema{Enum: []string{"a", "b"}, Maximum: &oldMaximum},
+		&project.Schema{Enum: []string{"a"}, Maximum: &newMaximum, Pattern: &pattern})
+
+	assert.Equal(t, []string{"enum value b is removed", "maximum changed to 5", "pattern changed to ^a$"}, tightened)
+	assert.Empty(t, loosened)
+}
+
+func TestGetVersion(t *testing.T) {
+	breaking := []Change{{Type: Removed, Breaking: true}}
+
+	assert.True(t, getVersion("1.2.0", "2.0.0", breaking).Valid)
+	assert.True(t, getVersion("0.2.0", "0.3.0", breaking).Valid)
+	assert.True(t, getVersion("v1.2.3", "v1.2.4-rc.1", []Change{{Type: Changed}}).Valid)
+	assert.False(t, getVersion("1.2.0", "1.2.1", []Change{{Type: Added}}).Valid)
+	assert.False(t, getVersion("1.2.0", "1.2.1", []Change{{Type: Changed, Widening: true}}).Valid)
+	assert.Equal(t, "version 1.1.0 is lower than 1.2.0", getVersion("1.2.0", "1.1.0", nil).Message)
+	assert.Equal(t, "version isn't a semantic version (e.g. 1.0.0)", getVersion("1.2", "1.3", nil).Message)
+}
+
+func TestReport_as_text(t *testing.T) {
+	binary, err := Report(Text, &Result{Changes: []Change{{Type: Removed, Breaking: true, Path: "build test", Message: "command 'build test' is removed"}},
+		Version: &Version{Old: "1.2.0", New: "2.0.0", Required: Major, Actual: Major, Valid: true}})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "build test: breaking removed: command 'build test' is removed\nversion 1.2.0 -> 2.0.0: major bump, major bump required\n", string(binary))
+}
+
+func doDiffTest(t *testing.T, oldContent string, newContent string) *Result {
+	result, err := Diff(internal.NewContext("old.yaml", []byte(oldContent)), internal.NewContext("new.yaml", []byte(newContent)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return result
+}
@@ -0,0 +1,48 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/raitonbl/ant/internal"
+)
+
+type Format string
+
+const (
+	Text Format = "text"
+	Json Format = "json"
+)
+
+func Report(format Format, result *Result) ([]byte, error) {
+
+	switch format {
+	case Text:
+		return doReportText(result), nil
+	case Json:
+		return json.MarshalIndent(result, "", "  ")
+	default:
+		return nil, internal.GetProblemFactory().GetUnsupportedFormat(string(format))
+	}
+}
+
+func doReportText(result *Result) []byte {
+	txt := ""
+
+	for _, each := range result.Changes {
+		kind := "non-breaking"
+
+		if each.Breaking {
+			kind = "breaking"
+		}
+
+		txt += fmt.Sprintf("%s: %s %s: %s\n", each.Path, kind, each.Type, each.Message)
+	}
+
+	if version := result.Version; version != nil && version.Valid {
+		txt += fmt.Sprintf("version %s -> %s: %s bump, %s bump required\n", version.Old, version.New, version.Actual, version.Required)
+	} else if version != nil {
+		txt += fmt.Sprintf("version %s -> %s: %s\n", version.Old, version.New, version.Message)
+	}
+
+	return []byte(txt)
+}
@@ -0,0 +1,160 @@
+package diff
+
+import (
+	"fmt"
+	"github.com/raitonbl/ant/internal/utils"
+	"github.com/raitonbl/ant/pkg/project"
+)
+
+// doDiffSchema returns what makes the new schema reject values the old one accepts (tightened) and the other way around (loosened)
+func doDiffSchema(oldSchema *project.Schema, newSchema *project.Schema) ([]string, []string) {
+	tightened, loosened := make([]string, 0), make([]string, 0)
+
+	if oldSchema == nil {
+		oldSchema = &project.Schema{}
+	}
+
+	if newSchema == nil {
+		newSchema = &project.Schema{}
+	}
+
+	if oldType, newType := getType(oldSchema), getType(newSchema); oldType != newType {
+		return append(tightened, fmt.Sprintf("type changed from %s to %s", oldType, newType)), loosened
+	}
+
+	if oldFormat, newFormat := getFormat(oldSchema), getFormat(newSchema); oldFormat != newFormat && newFormat != "" {
+		tightened = append(tightened, fmt.Sprintf("format changed to %s", newFormat))
+	} else if oldFormat != newFormat {
+		loosened = append(loosened, fmt.Sprintf("format %s is removed", oldFormat))
+	}
+
+	doDiffEnum(oldSchema.Enum, newSchema.Enum, &tightened, &loosened)
+
+	doDiffLowerBound("minimum", oldSchema.Minimum, newSchema.Minimum, &tightened, &loosened)
+	doDiffUpperBound("maximum", oldSchema.Maximum, newSchema.Maximum, &tightened, &loosened)
+	doDiffFlag("exclusive-minimum", oldSchema.ExclusiveMinimum, newSchema.ExclusiveMinimum, &tightened, &loosened)
+	doDiffFlag("exclusive-maximum", oldSchema.ExclusiveMaximum, newSchema.ExclusiveMaximum, &tightened, &loosened)
+	doDiffLowerBound("min-length", oldSchema.MinLength, newSchema.MinLength, &tightened, &loosened)
+	doDiffUpperBound("max-length", oldSchema.MaxLength, newSchema.MaxLength, &tightened, &loosened)
+	doDiffLowerBound("min-items", oldSchema.MinItems, newSchema.MinItems, &tightened, &loosened)
+	doDiffUpperBound("max-items", oldSchema.MaxItems, newSchema.MaxItems, &tightened, &loosened)
+	doDiffFlag("unique-items", oldSchema.UniqueItems, newSchema.UniqueItems, &tightened, &loosened)
+
+	if oldValue, newValue := getInt(oldSchema.MultipleOf), getInt(newSchema.MultipleOf); oldValue != newValue && newValue != "none" {
+		tightened = append(tightened, fmt.Sprintf("multiple-of changed to %s", newValue))
+	} else if oldValue != newValue {
+		loosened = append(loosened, "multiple-of is removed")
+	}
+
+	if oldValue, newValue := utils.GetText(oldSchema.Pattern), utils.GetText(newSchema.Pattern); oldValue != newValue && newValue != "" {
+		tightened = append(tightened, fmt.Sprintf("pattern changed to %s", newValue))
+	} else if oldValue != newValue {
+		loosened = append(loosened, "pattern is removed")
+	}
+
+	if oldSchema.Items != nil || newSchema.Items != nil {
+		array, other := doDiffSchema(oldSchema.Items, newSchema.Items)
+
+		for _, each := range array {
+			tightened = append(tightened, "items "+each)
+		}
+
+		for _, each := range other {
+			loosened = append(loosened, "items "+each)
+		}
+	}
+
+	return tightened, loosened
+}
+
+func doDiffEnum(oldEnum []string, newEnum []string, tightened *[]string, loosened *[]string) {
+
+	if len(oldEnum) == 0 && len(newEnum) > 0 {
+		*tightened = append(*tightened, "enum is added")
+		return
+	}
+
+	if len(oldEnum) > 0 && len(newEnum) == 0 {
+		*loosened = append(*loosened, "enum is removed")
+		return
+	}
+
+	for _, each := range oldEnum {
+		if !contains(newEnum, each) {
+			*tightened = append(*tightened, fmt.Sprintf("enum value %s is removed", each))
+		}
+	}
+
+	for _, each := range newEnum {
+		if !contains(oldEnum, each) {
+			*loosened = append(*loosened, fmt.Sprintf("enum value %s is added", each))
+		}
+	}
+}
+
+// doDiffLowerBound compares a bound which tightens the schema as it increases (e.g. minimum)
+func doDiffLowerBound(name string, oldValue *int, newValue *int, tightened *[]string, loosened *[]string) {
+
+	if newValue != nil && (oldValue == nil || *newValue > *oldValue) {
+		*tightened = append(*tightened, fmt.Sprintf("%s changed to %d", name, *newValue))
+	} else if oldValue != nil && (newValue == nil || *newValue < *oldValue) {
+		*loosened = append(*loosened, fmt.Sprintf("%s changed to %s", name, getInt(newValue)))
+	}
+}
+
+// doDiffUpperBound compares a bound which tightens the schema as it decreases (e.g. maximum)
+func doDiffUpperBound(name string, oldValue *int, newValue *int, tightened *[]string, loosened *[]string) {
+
+	if newValue != nil && (oldValue == nil || *newValue < *oldValue) {
+		*tightened = append(*tightened, fmt.Sprintf("%s changed to %d", name, *newValue))
+	} else if oldValue != nil && (newValue == nil || *newValue > *oldValue) {
+		*loosened = append(*loosened, fmt.Sprintf("%s changed to %s", name, getInt(newValue)))
+	}
+}
+
+// doDiffFlag compares a flag which tightens the schema when it is turned on (e.g. unique-items)
+func doDiffFlag(name string, oldValue *bool, newValue *bool, tightened *[]string, loosened *[]string) {
+	isOld, isNew := oldValue != nil && *oldValue, newValue != nil && *newValue
+
+	if isNew && !isOld {
+		*tightened = append(*tightened, fmt.Sprintf("%s is added", name))
+	} else if isOld && !isNew {
+		*loosened = append(*loosened, fmt.Sprintf("%s is removed", name))
+	}
+}
+
+func getType(schema *project.Schema) project.SchemaType {
+
+	if schema.TypeOf == nil {
+		return project.String
+	}
+
+	return *schema.TypeOf
+}
+
+func getFormat(schema *project.Schema) project.SchemaFormat {
+
+	if schema.Format == nil {
+		return ""
+	}
+
+	return *schema.Format
+}
+
+func getInt(value *int) string {
+
+	if value == nil {
+		return "none"
+	}
+
+	return fmt.Sprintf("%d", *value)
+}
+
+func contains(array []string, value string) bool {
+	for _, each := range array {
+		if each == value {
+			return true
+		}
+	}
+	return false
+}
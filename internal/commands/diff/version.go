@@ -0,0 +1,124 @@
+package diff
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Bump string
+
+const (
+	None  Bump = "none"
+	Patch Bump = "patch"
+	Minor Bump = "minor"
+	Major Bump = "major"
+)
+
+// Version tells whether the version of the new specification is bumped according to semantic versioning
+type Version struct {
+	Old      string `json:"old"`
+	New      string `json:"new"`
+	Required Bump   `json:"required"`
+	Actual   Bump   `json:"actual"`
+	Valid    bool   `json:"valid"`
+	Message  string `json:"message,omitempty"`
+}
+
+func getVersion(oldVersion string, newVersion string, changes []Change) *Version {
+	object := &Version{Old: oldVersion, New: newVersion, Required: getRequiredBump(oldVersion, changes), Actual: None}
+	oldNumbers, isOldValid := parseVersion(oldVersion)
+	newNumbers, isNewValid := parseVersion(newVersion)
+
+	if !isOldValid || !isNewValid {
+		object.Message = "version isn't a semantic version (e.g. 1.0.0)"
+		return object
+	}
+
+	for index, bump := range []Bump{Major, Minor, Patch} {
+		if newNumbers[index] < oldNumbers[index] {
+			object.Message = fmt.Sprintf("version %s is lower than %s", newVersion, oldVersion)
+			return object
+		}
+
+		if newNumbers[index] > oldNumbers[index] {
+			object.Actual = bump
+			break
+		}
+	}
+
+	object.Valid = getRank(object.Actual) >= getRank(object.Required)
+
+	if !object.Valid {
+		object.Message = fmt.Sprintf("a %s bump is required, but version %s is a %s bump of %s", object.Required, newVersion, object.Actual, oldVersion)
+	}
+
+	return object
+}
+
+// getRequiredBump returns the bump the changes require, where widening a parameter requires a minor bump and a breaking
+// change only requires a minor bump before 1.0.0
+func getRequiredBump(oldVersion string, changes []Change) Bump {
+	bump := None
+
+	for _, each := range changes {
+		required := Patch
+
+		if each.Breaking {
+			required = Major
+		} else if each.Type == Added || each.Type == Deprecated || each.Widening {
+			required = Minor
+		}
+
+		if getRank(required) > getRank(bump) {
+			bump = required
+		}
+	}
+
+	if numbers, isValid := parseVersion(oldVersion); isValid && numbers[0] == 0 && bump == Major {
+		return Minor
+	}
+
+	return bump
+}
+
+func getRank(bump Bump) int {
+	switch bump {
+	case Major:
+		return 3
+	case Minor:
+		return 2
+	case Patch:
+		return 1
+	}
+	return 0
+}
+
+// parseVersion returns the major, minor and patch of the version, ignoring the pre-release and build metadata
+func parseVersion(value string) ([]int, bool) {
+	txt := strings.TrimPrefix(value, "v")
+
+	if index := strings.IndexAny(txt, "-+"); index != -1 {
+		txt = txt[:index]
+	}
+
+	parts := strings.Split(txt, ".")
+
+	if len(parts) != 3 {
+		return nil, false
+	}
+
+	numbers := make([]int, 0, 3)
+
+	for _, each := range parts {
+		number, err := strconv.Atoi(each)
+
+		if err != nil || number < 0 {
+			return nil, false
+		}
+
+		numbers = append(numbers, number)
+	}
+
+	return numbers, true
+}
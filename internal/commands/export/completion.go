@@ -0,0 +1,239 @@
+package export
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/internal/utils"
+	"github.com/raitonbl/ant/pkg/project"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+var (
+	//go:embed templates/completion.*.tmpl
+	completionTemplates embed.FS
+)
+
+type Shell string
+
+const (
+	Bash       Shell = "bash"
+	Zsh        Shell = "zsh"
+	Fish       Shell = "fish"
+	PowerShell Shell = "powershell"
+)
+
+type CompletionDocument struct {
+	Name     string
+	Function string
+	Commands []*CompletionCommand
+}
+
+// CompletionCommand is a command of the tree, where the root has a blank path
+type CompletionCommand struct {
+	Path        string
+	Subcommands []CompletionItem
+	Flags       []CompletionFlag
+	Arguments   []CompletionArgument
+}
+
+// CompletionItem is a subcommand, which name is either the name of the command or one of its aliases
+type CompletionItem struct {
+	Name        string
+	Command     string
+	Description string
+}
+
+type CompletionFlag struct {
+	Name        string
+	ShortForm   string
+	Description string
+	HasValue    bool
+	Values      []string
+}
+
+// CompletionArgument holds the values of the argument at the position, which is where it is bound from
+type CompletionArgument struct {
+	Position int
+	Values   []string
+}
+
+func Completion(ctx internal.ProjectContext, shell Shell) ([]byte, error) {
+
+	document, err := internal.GetDocument(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if shell != Bash && shell != Zsh && shell != Fish && shell != PowerShell {
+		return nil, internal.GetProblemFactory().GetUnsupportedFormat(string(shell))
+	}
+
+	filename := fmt.Sprintf("templates/completion.%s.tmpl", shell)
+	tmpl, err := template.New(filename[len("templates/"):]).Funcs(getCompletionFunctions(shell)).ParseFS(completionTemplates, filename)
+
+	if err != nil {
+		return nil, internal.GetProblemFactory().GetProblem(err)
+	}
+
+	buffer := &bytes.Buffer{}
+
+	if err = tmpl.Execute(buffer, newCompletionDocument(document)); err != nil {
+		return nil, internal.GetProblemFactory().GetProblem(err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func newCompletionDocument(document *project.Specification) *CompletionDocument {
+	name := utils.GetText(document.Name)
+	object := &CompletionDocument{Name: name, Function: toFunctionName(name)}
+	root := &CompletionCommand{}
+	object.Commands = append(object.Commands, root)
+
+	for _, each := range getVisibleCommands(document.GetCommands()) {
+		root.Subcommands = append(root.Subcommands, newCompletionItems(each)...)
+		doAddCompletionCommand(object, document, each)
+	}
+
+	return object
+}
+
+func doAddCompletionCommand(object *CompletionDocument, document *project.Specification, instance *project.ResolvedCommand) {
+	command := &CompletionCommand{Path: strings.Join(instance.Path, " ")}
+	object.Commands = append(object.Commands, command)
+
+	for _, each := range getVisibleCommands(instance.Subcommands) {
+		command.Subcommands = append(command.Subcommands, newCompletionItems(each)...)
+	}
+
+	for _, each := range instance.GetFlags() {
+		param := each.Parameter
+		flag := CompletionFlag{Name: param.GetName(), ShortForm: utils.GetText(param.ShortForm), Description: toLine(param.Description),
+			HasValue: !isBoolean(param.Schema), Values: getEnum(param.Schema)}
+
+		if env := document.GetEnv(param); env != "" {
+			flag.Description = strings.TrimSpace(fmt.Sprintf("%s [env: %s]", flag.Description, env))
+		}
+
+		command.Flags = append(command.Flags, flag)
+	}
+
+	for index, each := range instance.GetArguments() {
+		if values := getEnum(each.Parameter.Schema); len(values) > 0 {
+			command.Arguments = append(command.Arguments, CompletionArgument{Position: index, Values: values})
+		}
+	}
+
+	for _, each := range getVisibleCommands(instance.Subcommands) {
+		doAddCompletionCommand(object, document, each)
+	}
+}
+
+// newCompletionItems returns the items which invoke the command, meaning its name followed by its aliases
+func newCompletionItems(command *project.ResolvedCommand) []CompletionItem {
+	description := toLine(command.Command.Description)
+	array := []CompletionItem{{Name: command.GetName(), Command: command.GetName(), Description: description}}
+
+	for _, each := range command.Command.Aliases {
+		array = append(array, CompletionItem{Name: each, Command: command.GetName(), Description: description})
+	}
+
+	return array
+}
+
+// IsAlias determines whether the item is an alias of the command, rather than its name
+func (instance CompletionItem) IsAlias() bool {
+	return instance.Name != instance.Command
+}
+
+// GetNames returns the long form followed by the short form of the flag, if any
+func (instance CompletionFlag) GetNames() []string {
+	array := []string{"--" + instance.Name}
+
+	if instance.ShortForm != "" {
+		array = append(array, "-"+instance.ShortForm)
+	}
+
+	return array
+}
+
+func getCompletionFunctions(shell Shell) template.FuncMap {
+	return template.FuncMap{
+		"quote": getQuoteFunction(shell),
+		"split": strings.Fields,
+		"words": func(array []string) string {
+			return strings.Join(array, " ")
+		},
+		"names": func(array []CompletionItem) string {
+			names := make([]string, 0, len(array))
+
+			for _, each := range array {
+				names = append(names, each.Name)
+			}
+
+			return strings.Join(names, " ")
+		},
+		"flags": func(array []CompletionFlag, hasValue bool) string {
+			names := make([]string, 0)
+
+			for _, each := range array {
+				if each.HasValue || !hasValue {
+					names = append(names, each.GetNames()...)
+				}
+			}
+
+			return strings.Join(names, " ")
+		},
+	}
+}
+
+// getQuoteFunction returns the function that turns a text into a single quoted literal of the shell
+func getQuoteFunction(shell Shell) func(string) string {
+
+	switch shell {
+	case Fish:
+		return func(value string) string {
+			return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+		}
+	case PowerShell:
+		return func(value string) string {
+			return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+		}
+	}
+
+	return func(value string) string {
+		return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+	}
+}
+
+func getEnum(schema *project.Schema) []string {
+
+	if schema == nil {
+		return nil
+	}
+
+	if schema.TypeOf != nil && *schema.TypeOf == project.Array && schema.Items != nil {
+		return schema.Items.Enum
+	}
+
+	return schema.Enum
+}
+
+func toFunctionName(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, value)
+}
+
+// toLine turns the text into a single line, as required by completion descriptions
+func toLine(value *string) string {
+	return strings.Join(strings.Fields(utils.GetText(value)), " ")
+}
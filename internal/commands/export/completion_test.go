@@ -0,0 +1,104 @@
+package export
+
+import (
+	"github.com/raitonbl/ant/internal"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestCompletion_for_bash(t *testing.T) {
+	txt := doExportCompletion(t, "../lint/testdata/index-067.yaml", Bash)
+
+	assert.True(t, strings.Contains(txt, `'') echo 'build' ;;`))
+	assert.True(t, strings.Contains(txt, `'build stack') echo '--language -l --verbose -v' ;;`))
+	assert.True(t, strings.Contains(txt, `'build stack') echo '--language -l' ;;`))
+	assert.True(t, strings.Contains(txt, `'build stack|--language'|'build stack|-l') echo 'java golang' ;;`))
+	assert.True(t, strings.Contains(txt, `'build stack|0') echo 'project tests' ;;`))
+	assert.True(t, strings.Contains(txt, `complete -o default -F _cli cli`))
+}
+
+func TestCompletion_for_zsh(t *testing.T) {
+	txt := doExportCompletion(t, "../lint/testdata/index-067.yaml", Zsh)
+
+	assert.True(t, strings.HasPrefix(txt, "#compdef cli\n"))
+	assert.True(t, strings.Contains(txt, `'build') print -l -- 'stack:builds the project of the user'\''s stack' ;;`))
+	assert.True(t, strings.Contains(txt, `'-v:indicates whether every step is written'`))
+	assert.True(t, strings.Contains(txt, `'build stack|0') print -l -- 'project' 'tests' ;;`))
+}
+
+func TestCompletion_for_fish(t *testing.T) {
+	txt := doExportCompletion(t, "../lint/testdata/index-067.yaml", Fish)
+
+	assert.True(t, strings.Contains(txt, `complete -c cli -f -n '__cli_is_command \'build\'' -a 'stack' -d 'builds the project of the user\'s stack'`))
+	assert.True(t, strings.Contains(txt, `complete -c cli -n '__cli_is_command \'build stack\'' -l 'language' -s 'l' -x -a 'java golang' -d 'indicates the programming language'`))
+	assert.True(t, strings.Contains(txt, `complete -c cli -n '__cli_is_command \'build stack\'' -l 'verbose' -s 'v' -d 'indicates whether every step is written'`))
+	assert.True(t, strings.Contains(txt, `complete -c cli -f -n '__cli_is_argument \'build stack\' 0' -a 'project tests'`))
+}
+
+func TestCompletion_for_powershell(t *testing.T) {
+	txt := doExportCompletion(t, "../lint/testdata/index-067.yaml", PowerShell)
+
+	assert.True(t, strings.Contains(txt, `Register-ArgumentCompleter -Native -CommandName 'cli'`))
+	assert.True(t, strings.Contains(txt, `'build stack' = @('--language', '-l', '--verbose', '-v')`))
+	assert.True(t, strings.Contains(txt, `'build stack|-l' = @('java', 'golang')`))
+	assert.True(t, strings.Contains(txt, `'build|stack' = 'builds the project of the user''s stack'`))
+}
+
+func TestCompletion_where_command_is_hidden(t *testing.T) {
+	txt := doExportCompletion(t, "../lint/testdata/index-071.yaml", Bash)
+
+	assert.True(t, strings.Contains(txt, `'') echo 'build b' ;;`))
+	assert.True(t, strings.Contains(txt, `'build') echo 'stack s' ;;`))
+	assert.False(t, strings.Contains(txt, "debug"))
+	assert.False(t, strings.Contains(txt, "image"))
+}
+
+func TestCompletion_where_command_has_aliases(t *testing.T) {
+	txt := doExportCompletion(t, "../lint/testdata/index-071.yaml", Bash)
+	assert.True(t, strings.Contains(txt, `'|b') echo 'build' ;;`))
+	assert.True(t, strings.Contains(txt, `'build|s') echo 'stack' ;;`))
+
+	txt = doExportCompletion(t, "../lint/testdata/index-071.yaml", Zsh)
+	assert.True(t, strings.Contains(txt, `'build|s') print -- 'stack' ;;`))
+
+	txt = doExportCompletion(t, "../lint/testdata/index-071.yaml", Fish)
+	assert.True(t, strings.Contains(txt, `complete -c cli -f -n '__cli_is_command \'build\'' -a 's' -d 'allows to build the stack of the user'`))
+	assert.True(t, strings.Contains(txt, "case 'build|s'\n            echo 'stack'"))
+
+	txt = doExportCompletion(t, "../lint/testdata/index-071.yaml", PowerShell)
+	assert.True(t, strings.Contains(txt, `'build|s' = 'stack'`))
+}
+
+func TestCompletion_where_flag_is_bound_to_env(t *testing.T) {
+	txt := doExportCompletion(t, "../lint/testdata/index-073.yaml", Zsh)
+
+	assert.True(t, strings.Contains(txt, `'--config:indicates the configuration file [env: CLI_CONFIG]'`))
+}
+
+func TestCompletion_where_shell_is_unsupported(t *testing.T) {
+	ctx, err := internal.GetContext("../lint/testdata/index-067.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = Completion(ctx, "tcsh")
+	assert.NotNil(t, err)
+}
+
+func doExportCompletion(t *testing.T, filename string, shell Shell) string {
+	ctx, err := internal.GetContext(filename)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	binary, err := Completion(ctx, shell)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(binary)
+}
@@ -0,0 +1,180 @@
+package export
+
+import (
+	"fmt"
+	"github.com/raitonbl/ant/internal/utils"
+	"github.com/raitonbl/ant/pkg/project"
+	"strings"
+)
+
+// getVisibleCommands returns the commands which aren't hidden, given that a hidden command is left out together with its subcommands
+func getVisibleCommands(array []*project.ResolvedCommand) []*project.ResolvedCommand {
+	commands := make([]*project.ResolvedCommand, 0, len(array))
+
+	for _, each := range array {
+		if !each.Command.IsHidden() {
+			commands = append(commands, each)
+		}
+	}
+
+	return commands
+}
+
+func getUsage(executable string, command *project.ResolvedCommand) string {
+	usage := append([]string{executable}, command.Path...)
+
+	for _, each := range command.GetArguments() {
+		param := each.Parameter
+		name := utils.GetText(param.Name)
+
+		if param.IsVariadic() {
+			name += "..."
+		}
+
+		if param.DefaultValue != nil {
+			usage = append(usage, fmt.Sprintf("[%s]", name))
+		} else {
+			usage = append(usage, fmt.Sprintf("<%s>", name))
+		}
+	}
+
+	for _, each := range command.GetFlags() {
+		param := each.Parameter
+		flag := fmt.Sprintf("--%s", utils.GetText(param.Name))
+
+		if !isBoolean(param.Schema) {
+			flag = fmt.Sprintf("%s <%s>", flag, getTypeOf(param.Schema))
+		}
+
+		if param.IsRequired() {
+			usage = append(usage, flag)
+		} else {
+			usage = append(usage, fmt.Sprintf("[%s]", flag))
+		}
+	}
+
+	return strings.Join(usage, " ")
+}
+
+func getConstraints(schema *project.Schema) []string {
+	array := make([]string, 0)
+
+	if schema == nil {
+		return array
+	}
+
+	if schema.Minimum != nil && schema.ExclusiveMinimum != nil && *schema.ExclusiveMinimum {
+		array = append(array, fmt.Sprintf("> %d", *schema.Minimum))
+	} else if schema.Minimum != nil {
+		array = append(array, fmt.Sprintf(">= %d", *schema.Minimum))
+	}
+
+	if schema.Maximum != nil && schema.ExclusiveMaximum != nil && *schema.ExclusiveMaximum {
+		array = append(array, fmt.Sprintf("< %d", *schema.Maximum))
+	} else if schema.Maximum != nil {
+		array = append(array, fmt.Sprintf("<= %d", *schema.Maximum))
+	}
+
+	if schema.MultipleOf != nil {
+		array = append(array, fmt.Sprintf("multiple of %d", *schema.MultipleOf))
+	}
+
+	if schema.MinLength != nil {
+		array = append(array, fmt.Sprintf("min-length %d", *schema.MinLength))
+	}
+
+	if schema.MaxLength != nil {
+		array = append(array, fmt.Sprintf("max-length %d", *schema.MaxLength))
+	}
+
+	if schema.Pattern != nil {
+		array = append(array, fmt.Sprintf("pattern %s", *schema.Pattern))
+	}
+
+	if schema.MinItems != nil {
+		array = append(array, fmt.Sprintf("min-items %d", *schema.MinItems))
+	}
+
+	if schema.MaxItems != nil {
+		array = append(array, fmt.Sprintf("max-items %d", *schema.MaxItems))
+	}
+
+	if schema.UniqueItems != nil && *schema.UniqueItems {
+		array = append(array, "unique items")
+	}
+
+	if len(schema.Enum) > 0 {
+		array = append(array, fmt.Sprintf("one of %s", strings.Join(schema.Enum, ", ")))
+	}
+
+	if len(schema.Examples) > 0 {
+		array = append(array, fmt.Sprintf("e.g. %s", strings.Join(schema.Examples, ", ")))
+	}
+
+	return array
+}
+
+func getTypeOf(schema *project.Schema) string {
+
+	if schema == nil || schema.TypeOf == nil {
+		return string(project.String)
+	}
+
+	typeOf := string(*schema.TypeOf)
+
+	if *schema.TypeOf == project.Array && schema.Items != nil && schema.Items.TypeOf != nil {
+		typeOf = fmt.Sprintf("%s[]", *schema.Items.TypeOf)
+	}
+
+	return typeOf
+}
+
+func getFormat(schema *project.Schema) string {
+
+	if schema == nil || schema.Format == nil {
+		return ""
+	}
+
+	return string(*schema.Format)
+}
+
+// getDeprecation returns the notice of a deprecated definition (e.g. Deprecated since 1.2.0, use --output instead.), where replacement
+// formats the replaced-by (e.g. --%s). It returns a blank text when the definition isn't deprecated
+func getDeprecation(value *project.Deprecation, replacement string) string {
+
+	if !value.IsDeprecated() {
+		return ""
+	}
+
+	txt := "Deprecated"
+
+	if value.Since != nil {
+		txt += " since " + *value.Since
+	}
+
+	if value.ReplacedBy != nil {
+		txt += ", use " + fmt.Sprintf(replacement, *value.ReplacedBy) + " instead"
+	}
+
+	txt += "."
+
+	if message := toLine(value.Message); message != "" {
+		txt += " " + message
+	}
+
+	return txt
+}
+
+// getReplacementFormat returns the format of the parameter which replaces the deprecated parameter, given it is of the same kind
+func getReplacementFormat(param *project.Parameter) string {
+
+	if param.IsArgument() {
+		return "%s"
+	}
+
+	return "--%s"
+}
+
+func isBoolean(schema *project.Schema) bool {
+	return schema != nil && schema.TypeOf != nil && *schema.TypeOf == project.Bool
+}
@@ -0,0 +1,222 @@
+package export
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/internal/utils"
+	"github.com/raitonbl/ant/pkg/project"
+	"html/template"
+	"strings"
+)
+
+var (
+	//go:embed templates/index.html.tmpl
+	htmlTemplates embed.FS
+)
+
+type HtmlDocument struct {
+	Name        string
+	Version     string
+	Description string
+	Tree        []*HtmlCommand
+	Commands    []*HtmlCommand
+	Parameters  []HtmlParameter
+	Exit        []HtmlExit
+	Schemas     []HtmlSchema
+}
+
+type HtmlCommand struct {
+	Anchor      string
+	Name        string
+	Path        string
+	Description string
+	Usage       string
+	Aliases     []string
+	Deprecated  string
+	Subcommands []*HtmlCommand
+	Arguments   []HtmlParameter
+	Flags       []HtmlParameter
+	Exit        []HtmlExit
+}
+
+type HtmlParameter struct {
+	Anchor      string
+	Id          string
+	In          string
+	Index       string
+	Name        string
+	ShortForm   string
+	Description string
+	Type        string
+	Format      string
+	Default     string
+	Env         string
+	Required    bool
+	Constraints []string
+	// RefersTo and Schema only hold references to an anchor within the document, unlike Reference and SchemaReference
+	RefersTo        string
+	Reference       string
+	Schema          string
+	SchemaReference string
+	Deprecated      string
+}
+
+type HtmlExit struct {
+	Anchor      string
+	Id          string
+	Code        string
+	Message     string
+	Description string
+	RefersTo    string
+	Reference   string
+	Deprecated  string
+}
+
+type HtmlSchema struct {
+	Anchor      string
+	Id          string
+	Type        string
+	Format      string
+	Constraints []string
+}
+
+func Html(ctx internal.ProjectContext) ([]byte, error) {
+
+	document, err := internal.GetDocument(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.ParseFS(htmlTemplates, "templates/index.html.tmpl")
+
+	if err != nil {
+		return nil, internal.GetProblemFactory().GetProblem(err)
+	}
+
+	buffer := &bytes.Buffer{}
+
+	if err = tmpl.Execute(buffer, newHtmlDocument(document)); err != nil {
+		return nil, internal.GetProblemFactory().GetProblem(err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func newHtmlDocument(document *project.Specification) *HtmlDocument {
+	object := &HtmlDocument{Name: utils.GetText(document.Name), Version: utils.GetText(document.Version), Description: utils.GetText(document.Description)}
+	object.Tree = make([]*HtmlCommand, 0)
+
+	for _, each := range getVisibleCommands(document.GetCommands()) {
+		object.Tree = append(object.Tree, doAddHtmlCommand(object, document, each))
+	}
+
+	for _, each := range document.Parameters {
+		resolved := document.ResolveParameter(each)
+
+		if resolved == nil {
+			continue
+		}
+
+		param := newHtmlParameter(document, resolved)
+		param.Anchor = fmt.Sprintf("parameter-%s", utils.GetText(each.Id))
+		object.Parameters = append(object.Parameters, param)
+	}
+
+	for _, each := range document.Exit {
+		exit := newHtmlExit(&each)
+		exit.Anchor = fmt.Sprintf("exit-%s", utils.GetText(each.Id))
+		object.Exit = append(object.Exit, exit)
+	}
+
+	for _, each := range document.Schemas {
+		object.Schemas = append(object.Schemas, HtmlSchema{Anchor: fmt.Sprintf("schema-%s", utils.GetText(each.Id)), Id: utils.GetText(each.Id),
+			Type: getTypeOf(each), Format: getFormat(each), Constraints: getConstraints(each)})
+	}
+
+	return object
+}
+
+func doAddHtmlCommand(object *HtmlDocument, document *project.Specification, instance *project.ResolvedCommand) *HtmlCommand {
+	command := newHtmlCommand(document, instance)
+	object.Commands = append(object.Commands, command)
+
+	for _, each := range getVisibleCommands(instance.Subcommands) {
+		command.Subcommands = append(command.Subcommands, doAddHtmlCommand(object, document, each))
+	}
+
+	return command
+}
+
+func newHtmlCommand(document *project.Specification, instance *project.ResolvedCommand) *HtmlCommand {
+	command := &HtmlCommand{Anchor: fmt.Sprintf("command-%s", strings.Join(instance.Path, "-")), Name: instance.GetName(),
+		Path: strings.Join(instance.Path, " "), Description: utils.GetText(instance.Command.Description), Aliases: instance.Command.Aliases,
+		Deprecated: getDeprecation(instance.Command.Deprecated, utils.GetText(document.Name)+" %s")}
+
+	if instance.IsLeaf() {
+		command.Usage = getUsage(utils.GetText(document.Name), instance)
+	}
+
+	for _, each := range instance.GetArguments() {
+		command.Arguments = append(command.Arguments, newHtmlParameter(document, each))
+	}
+
+	for _, each := range instance.GetFlags() {
+		command.Flags = append(command.Flags, newHtmlParameter(document, each))
+	}
+
+	for _, each := range instance.Exit {
+		object := newHtmlExit(each.Exit)
+		object.RefersTo, object.Reference = getHtmlReference(each.RefersTo)
+		command.Exit = append(command.Exit, object)
+	}
+
+	return command
+}
+
+func newHtmlParameter(document *project.Specification, instance *project.ResolvedParameter) HtmlParameter {
+	param := instance.Parameter
+	schema := param.Schema
+	object := HtmlParameter{Id: utils.GetText(param.Id), Name: utils.GetText(param.Name), ShortForm: utils.GetText(param.ShortForm), Description: utils.GetText(param.Description),
+		Type: getTypeOf(schema), Format: getFormat(schema), Default: utils.GetText(param.DefaultValue), Env: document.GetEnv(param), Required: param.IsRequired(),
+		Constraints: getConstraints(schema), Deprecated: getDeprecation(param.Deprecated, getReplacementFormat(param))}
+
+	object.RefersTo, object.Reference = getHtmlReference(instance.RefersTo)
+	object.Schema, object.SchemaReference = getHtmlReference(instance.SchemaRefersTo)
+
+	if param.In != nil {
+		object.In = string(*param.In)
+	} else {
+		object.In = string(project.Flags)
+	}
+
+	if param.Index != nil {
+		object.Index = fmt.Sprintf("%d", *param.Index)
+	}
+
+	return object
+}
+
+// getHtmlReference returns the reference either as an anchor within the document or as text, given that a reference
+// into another file has no anchor within the document
+func getHtmlReference(value string) (string, string) {
+
+	if project.IsExternalReference(value) {
+		return "", value
+	}
+
+	return value, ""
+}
+
+func newHtmlExit(exit *project.Exit) HtmlExit {
+	object := HtmlExit{Id: utils.GetText(exit.Id), Message: utils.GetText(exit.Message), Description: utils.GetText(exit.Description),
+		Deprecated: getDeprecation(exit.Deprecated, "%s")}
+
+	if exit.Code != nil {
+		object.Code = fmt.Sprintf("%d", *exit.Code)
+	}
+
+	return object
+}
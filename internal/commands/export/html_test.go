@@ -0,0 +1,72 @@
+package export
+
+import (
+	"github.com/raitonbl/ant/internal"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestHtml_from_yaml(t *testing.T) {
+	txt := doExportHtml(t, "../lint/testdata/index-003.yaml")
+
+	assert.True(t, strings.Contains(txt, `<section id="command-build-stack">`))
+	assert.True(t, strings.Contains(txt, `<pre>cli build stack &lt;filename&gt; [--stack &lt;string&gt;]</pre>`))
+	assert.True(t, strings.Contains(txt, `<td><code>filename</code> (<a href="#parameter-filename">filename</a>)</td>`))
+	assert.True(t, strings.Contains(txt, `<li>one of java, python3, golang</li>`))
+	assert.True(t, strings.Contains(txt, `<td>2 (<a href="#exit-file-not-found">file-not-found</a>)</td>`))
+}
+
+func TestHtml_where_schema_has_refers_to(t *testing.T) {
+	txt := doExportHtml(t, "../lint/testdata/index-053.json")
+
+	assert.True(t, strings.Contains(txt, `<section id="schemas">`))
+	assert.True(t, strings.Contains(txt, `<td>string (<a href="#schema-language">language</a>)</td>`))
+}
+
+func TestHtml_where_refers_to_other_files(t *testing.T) {
+	txt := doExportHtml(t, "../lint/testdata/index-064.yaml")
+
+	assert.True(t, strings.Contains(txt, `<td><code>filename</code> (./shared/params.yaml#/parameters/filename)</td>`))
+	assert.True(t, strings.Contains(txt, `<td>2 (./shared/exit.yaml#/exit/file-not-found)</td>`))
+	assert.True(t, strings.Contains(txt, `<td>string (./shared/types.yaml#/schemas/format)</td>`))
+	assert.False(t, strings.Contains(txt, `href="#parameter-./`))
+}
+
+func TestHtml_where_deprecated(t *testing.T) {
+	txt := doExportHtml(t, "../lint/testdata/index-068.yaml")
+
+	assert.True(t, strings.Contains(txt, `<li><a href="#command-build">build</a> <em>(deprecated)</em> - allows to build the cli project</li>`))
+	assert.True(t, strings.Contains(txt, `<p class="deprecated">Deprecated since 1.1.0, use cli compile instead.</p>`))
+	assert.True(t, strings.Contains(txt, `<span class="deprecated">Deprecated since 1.1.0, use --target instead.</span>`))
+}
+
+func TestHtml_where_command_is_hidden(t *testing.T) {
+	txt := doExportHtml(t, "../lint/testdata/index-071.yaml")
+
+	assert.True(t, strings.Contains(txt, `<p>Aliases: <code>s</code></p>`))
+	assert.False(t, strings.Contains(txt, `command-debug`))
+	assert.False(t, strings.Contains(txt, `command-build-image`))
+}
+
+func TestHtml_where_flag_is_bound_to_env(t *testing.T) {
+	txt := doExportHtml(t, "../lint/testdata/index-073.yaml")
+
+	assert.True(t, strings.Contains(txt, `<td><code>$CLI_CONFIG</code>, else cli.yaml</td>`))
+}
+
+func doExportHtml(t *testing.T, filename string) string {
+	ctx, err := internal.GetContext(filename)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	binary, err := Html(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(binary)
+}
@@ -0,0 +1,201 @@
+package export
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/internal/utils"
+	"github.com/raitonbl/ant/pkg/project"
+	"strings"
+	"text/template"
+)
+
+var (
+	//go:embed templates/man.1.tmpl
+	manTemplates embed.FS
+)
+
+type ManPage struct {
+	Name        string
+	Program     string
+	Version     string
+	Summary     string
+	Synopsis    string
+	Aliases     []string
+	Deprecated  string
+	Subcommands []ManReference
+	Arguments   []ManOption
+	Options     []ManOption
+	Exit        []ManExit
+	SeeAlso     []string
+}
+
+type ManReference struct {
+	Name        string
+	Description string
+	Deprecated  bool
+}
+
+type ManOption struct {
+	Names       []string
+	Type        string
+	Description string
+	Default     string
+	Env         string
+	Required    bool
+	Enum        []string
+	Examples    []string
+	Constraints []string
+	Deprecated  string
+}
+
+type ManExit struct {
+	Code        string
+	Message     string
+	Description string
+	Deprecated  string
+}
+
+// Man returns a section 1 man page for the specification and for each of its commands, indexed by filename (e.g. ant-export.1)
+func Man(ctx internal.ProjectContext) (map[string][]byte, error) {
+
+	document, err := internal.GetDocument(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New("man.1.tmpl").Funcs(template.FuncMap{"escape": toRoff, "upper": strings.ToUpper}).
+		ParseFS(manTemplates, "templates/man.1.tmpl")
+
+	if err != nil {
+		return nil, internal.GetProblemFactory().GetProblem(err)
+	}
+
+	files := make(map[string][]byte)
+
+	for _, page := range newManPages(document) {
+		buffer := &bytes.Buffer{}
+
+		if err = tmpl.Execute(buffer, page); err != nil {
+			return nil, internal.GetProblemFactory().GetProblem(err)
+		}
+
+		files[page.Name+".1"] = buffer.Bytes()
+	}
+
+	return files, nil
+}
+
+func newManPages(document *project.Specification) []*ManPage {
+	program := utils.GetText(document.Name)
+	root := &ManPage{Name: program, Program: program, Version: utils.GetText(document.Version), Summary: toLine(document.Description),
+		Synopsis: fmt.Sprintf("%s <command>", program)}
+	pages := []*ManPage{root}
+
+	for _, each := range getVisibleCommands(document.GetCommands()) {
+		root.Subcommands = append(root.Subcommands, newManReference(each))
+		root.SeeAlso = append(root.SeeAlso, getManPageName(program, each))
+		pages = doAddManPage(pages, document, each)
+	}
+
+	return pages
+}
+
+func doAddManPage(pages []*ManPage, document *project.Specification, instance *project.ResolvedCommand) []*ManPage {
+	program := utils.GetText(document.Name)
+	page := &ManPage{Name: getManPageName(program, instance), Program: program, Version: utils.GetText(document.Version),
+		Summary: toLine(instance.Command.Description), Aliases: instance.Command.Aliases,
+		Deprecated: getDeprecation(instance.Command.Deprecated, program+" %s")}
+	pages = append(pages, page)
+
+	if instance.Parent == nil {
+		page.SeeAlso = append(page.SeeAlso, program)
+	} else {
+		page.SeeAlso = append(page.SeeAlso, getManPageName(program, instance.Parent))
+	}
+
+	if instance.IsLeaf() {
+		page.Synopsis = getUsage(program, instance)
+	} else {
+		page.Synopsis = fmt.Sprintf("%s %s <command>", program, strings.Join(instance.Path, " "))
+	}
+
+	for _, each := range getVisibleCommands(instance.Subcommands) {
+		page.Subcommands = append(page.Subcommands, newManReference(each))
+		page.SeeAlso = append(page.SeeAlso, getManPageName(program, each))
+	}
+
+	for _, each := range instance.GetArguments() {
+		page.Arguments = append(page.Arguments, newManOption(document, each.Parameter, []string{utils.GetText(each.Parameter.Name)}))
+	}
+
+	for _, each := range instance.GetFlags() {
+		param := each.Parameter
+		option := newManOption(document, param, []string{"--" + param.GetName()})
+
+		if param.ShortForm != nil {
+			option.Names = append(option.Names, "-"+*param.ShortForm)
+		}
+
+		page.Options = append(page.Options, option)
+	}
+
+	for _, each := range instance.Exit {
+		exit := ManExit{Message: utils.GetText(each.Exit.Message), Description: toLine(each.Exit.Description),
+			Deprecated: getDeprecation(each.Exit.Deprecated, "%s")}
+
+		if each.Exit.Code != nil {
+			exit.Code = fmt.Sprintf("%d", *each.Exit.Code)
+		}
+
+		page.Exit = append(page.Exit, exit)
+	}
+
+	for _, each := range getVisibleCommands(instance.Subcommands) {
+		pages = doAddManPage(pages, document, each)
+	}
+
+	return pages
+}
+
+func newManOption(document *project.Specification, param *project.Parameter, names []string) ManOption {
+	option := ManOption{Names: names, Description: toLine(param.Description), Default: utils.GetText(param.DefaultValue), Env: document.GetEnv(param),
+		Required: param.IsRequired(), Enum: getEnum(param.Schema), Deprecated: getDeprecation(param.Deprecated, getReplacementFormat(param))}
+
+	if param.Schema != nil {
+		option.Examples = param.Schema.Examples
+	}
+
+	if param.IsArgument() || !isBoolean(param.Schema) {
+		option.Type = getTypeOf(param.Schema)
+	}
+
+	for _, each := range getConstraints(param.Schema) {
+		if !strings.HasPrefix(each, "one of ") && !strings.HasPrefix(each, "e.g. ") {
+			option.Constraints = append(option.Constraints, each)
+		}
+	}
+
+	return option
+}
+
+func newManReference(command *project.ResolvedCommand) ManReference {
+	return ManReference{Name: command.GetName(), Description: toLine(command.Command.Description), Deprecated: command.Command.IsDeprecated()}
+}
+
+func getManPageName(program string, command *project.ResolvedCommand) string {
+	return strings.Join(append([]string{program}, command.Path...), "-")
+}
+
+// toRoff escapes the text, so that roff renders it as is
+func toRoff(value string) string {
+	txt := strings.NewReplacer(`\`, `\e`, `-`, `\-`).Replace(value)
+
+	if strings.HasPrefix(txt, ".") || strings.HasPrefix(txt, "'") {
+		txt = `\&` + txt
+	}
+
+	return txt
+}
@@ -0,0 +1,86 @@
+package export
+
+import (
+	"github.com/raitonbl/ant/internal"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestMan_from_yaml(t *testing.T) {
+	files := doExportMan(t, "../lint/testdata/index-003.yaml")
+
+	assert.Len(t, files, 5)
+	assert.Contains(t, files, "cli.1")
+	assert.Contains(t, files, "cli-build.1")
+	assert.Contains(t, files, "cli-build-stack.1")
+
+	txt := files["cli-lint.1"]
+
+	assert.True(t, strings.HasPrefix(txt, `.TH "CLI\-LINT" "1" "" "cli 1.0.0" "User Commands"`))
+	assert.True(t, strings.Contains(txt, ".SH SYNOPSIS\n.B cli lint <filename>\n"))
+	assert.True(t, strings.Contains(txt, ".SH EXIT STATUS\n.TP\n.B 1\nUnexpected behaviour\n.TP\n.B 2\nInput file not found\n"))
+	assert.True(t, strings.Contains(txt, ".SH SEE ALSO\n.BR cli (1)"))
+
+	txt = files["cli-build.1"]
+
+	assert.True(t, strings.Contains(txt, ".SH COMMANDS\n.TP\n.B stack\n"))
+	assert.True(t, strings.Contains(txt, ".SH SEE ALSO\n.BR cli (1),\n.BR cli\\-build\\-stack (1),\n.BR cli\\-build\\-test (1)"))
+}
+
+func TestMan_where_flags_have_short_form(t *testing.T) {
+	txt := doExportMan(t, "../lint/testdata/index-067.yaml")["cli-build-stack.1"]
+
+	assert.True(t, strings.Contains(txt, ".B cli build stack <target> [\\-\\-language <string>] [\\-\\-verbose]\n"))
+	assert.True(t, strings.Contains(txt, ".SH ARGUMENTS\n.TP\n\\fBtarget\\fR \\fIstring\\fR\nindicates what is built\n.br\nRequired.\n.br\nPossible values: project, tests\n"))
+	assert.True(t, strings.Contains(txt, "\\fB\\-\\-language\\fR, \\fB\\-l\\fR \\fIstring\\fR\n"))
+	assert.True(t, strings.Contains(txt, "\\fB\\-\\-verbose\\fR, \\fB\\-v\\fR\n"))
+}
+
+func TestMan_where_deprecated(t *testing.T) {
+	files := doExportMan(t, "../lint/testdata/index-068.yaml")
+
+	assert.True(t, strings.Contains(files["cli.1"], ".TP\n.B build (deprecated)\nallows to build the cli project\n"))
+
+	txt := files["cli-build.1"]
+
+	assert.True(t, strings.Contains(txt, ".SH DESCRIPTION\nallows to build the cli project\n.PP\n\\fBDeprecated since 1.1.0, use cli compile instead.\\fR\n"))
+	assert.True(t, strings.Contains(txt, "indicates the directory where the project is written\n.br\nDeprecated since 1.1.0, use \\-\\-target instead.\n"))
+	assert.True(t, strings.Contains(txt, "nothing to build\n.br\nDeprecated. the build no longer fails when there's nothing to build\n"))
+}
+
+func TestMan_where_command_is_hidden(t *testing.T) {
+	files := doExportMan(t, "../lint/testdata/index-071.yaml")
+
+	assert.Len(t, files, 3)
+	assert.NotContains(t, files, "cli-debug.1")
+	assert.True(t, strings.Contains(files["cli-build.1"], ".PP\nAliases: \\fBb\\fR\n"))
+}
+
+func TestMan_where_flag_is_bound_to_env(t *testing.T) {
+	txt := doExportMan(t, "../lint/testdata/index-073.yaml")["cli-build.1"]
+
+	assert.True(t, strings.Contains(txt, "indicates the configuration file\n.br\nEnvironment: CLI_CONFIG, when not given\n.br\nDefault: cli.yaml\n"))
+}
+
+func doExportMan(t *testing.T, filename string) map[string]string {
+	ctx, err := internal.GetContext(filename)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := Man(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	array := make(map[string]string)
+
+	for name, binary := range files {
+		array[name] = string(binary)
+	}
+
+	return array
+}
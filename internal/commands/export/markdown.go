@@ -0,0 +1,217 @@
+package export
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/internal/utils"
+	"github.com/raitonbl/ant/pkg/project"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+var (
+	//go:embed templates/markdown.md.tmpl
+	markdownTemplates embed.FS
+)
+
+// MarkdownIndex is the filename of the document which describes the CLI when each command has its own document
+const MarkdownIndex = "README.md"
+
+type MarkdownDocument struct {
+	Name        string
+	Version     string
+	Description string
+	Tree        []*MarkdownCommand
+	Commands    []*MarkdownCommand
+}
+
+type MarkdownCommand struct {
+	Title       string
+	Link        string
+	Name        string
+	Description string
+	Usage       string
+	Aliases     []string
+	Deprecated  string
+	Indentation string
+	Heading     string
+	Subheading  string
+	Subcommands []*MarkdownCommand
+	Arguments   []MarkdownParameter
+	Flags       []MarkdownParameter
+	Exit        []MarkdownExit
+}
+
+type MarkdownParameter struct {
+	Name        string
+	ShortForm   string
+	Type        string
+	Format      string
+	Default     string
+	Env         string
+	Required    bool
+	Constraints []string
+	Deprecated  string
+}
+
+type MarkdownExit struct {
+	Code        string
+	Message     string
+	Description string
+	Deprecated  string
+}
+
+// Markdown returns a GitHub flavoured Markdown document that describes the CLI
+func Markdown(ctx internal.ProjectContext) ([]byte, error) {
+	document, tmpl, err := getMarkdownContext(ctx, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return doExecuteMarkdown(tmpl, "document", document)
+}
+
+// MarkdownFiles returns a GitHub flavoured Markdown document for each command, together with the index that links them, indexed by filename
+func MarkdownFiles(ctx internal.ProjectContext) (map[string][]byte, error) {
+	document, tmpl, err := getMarkdownContext(ctx, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte)
+
+	if files[MarkdownIndex], err = doExecuteMarkdown(tmpl, "index", document); err != nil {
+		return nil, err
+	}
+
+	for _, each := range document.Commands {
+		if files[each.Link], err = doExecuteMarkdown(tmpl, "command", each); err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
+
+func getMarkdownContext(ctx internal.ProjectContext, split bool) (*MarkdownDocument, *template.Template, error) {
+
+	document, err := internal.GetDocument(ctx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tmpl, err := template.New("markdown.md.tmpl").Funcs(template.FuncMap{"cell": toMarkdownCell}).
+		ParseFS(markdownTemplates, "templates/markdown.md.tmpl")
+
+	if err != nil {
+		return nil, nil, internal.GetProblemFactory().GetProblem(err)
+	}
+
+	return newMarkdownDocument(document, split), tmpl, nil
+}
+
+func doExecuteMarkdown(tmpl *template.Template, name string, data interface{}) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+
+	if err := tmpl.ExecuteTemplate(buffer, name, data); err != nil {
+		return nil, internal.GetProblemFactory().GetProblem(err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func newMarkdownDocument(document *project.Specification, split bool) *MarkdownDocument {
+	object := &MarkdownDocument{Name: utils.GetText(document.Name), Version: utils.GetText(document.Version), Description: toLine(document.Description)}
+
+	for _, each := range getVisibleCommands(document.GetCommands()) {
+		object.Tree = append(object.Tree, doAddMarkdownCommand(object, document, each, split))
+	}
+
+	return object
+}
+
+func doAddMarkdownCommand(object *MarkdownDocument, document *project.Specification, instance *project.ResolvedCommand, split bool) *MarkdownCommand {
+	command := newMarkdownCommand(document, instance, split)
+	object.Commands = append(object.Commands, command)
+
+	for _, each := range getVisibleCommands(instance.Subcommands) {
+		command.Subcommands = append(command.Subcommands, doAddMarkdownCommand(object, document, each, split))
+	}
+
+	return command
+}
+
+func newMarkdownCommand(document *project.Specification, instance *project.ResolvedCommand, split bool) *MarkdownCommand {
+	title := strings.Join(append([]string{utils.GetText(document.Name)}, instance.Path...), " ")
+	command := &MarkdownCommand{Title: title, Name: instance.GetName(), Description: toLine(instance.Command.Description),
+		Indentation: strings.Repeat("  ", len(instance.Path)-1), Heading: "##", Subheading: "###", Link: "#" + toMarkdownAnchor(title),
+		Aliases: instance.Command.Aliases, Deprecated: getDeprecation(instance.Command.Deprecated, utils.GetText(document.Name)+" %s")}
+
+	if split {
+		command.Heading, command.Subheading = "#", "##"
+		command.Link = strings.ReplaceAll(title, " ", "-") + ".md"
+	}
+
+	if instance.IsLeaf() {
+		command.Usage = getUsage(utils.GetText(document.Name), instance)
+	}
+
+	for _, each := range instance.GetArguments() {
+		command.Arguments = append(command.Arguments, newMarkdownParameter(document, each.Parameter, utils.GetText(each.Parameter.Name)))
+	}
+
+	for _, each := range instance.GetFlags() {
+		command.Flags = append(command.Flags, newMarkdownParameter(document, each.Parameter, "--"+each.Parameter.GetName()))
+	}
+
+	for _, each := range instance.Exit {
+		exit := MarkdownExit{Message: utils.GetText(each.Exit.Message), Description: toLine(each.Exit.Description),
+			Deprecated: getDeprecation(each.Exit.Deprecated, "%s")}
+
+		if each.Exit.Code != nil {
+			exit.Code = fmt.Sprintf("%d", *each.Exit.Code)
+		}
+
+		command.Exit = append(command.Exit, exit)
+	}
+
+	return command
+}
+
+func newMarkdownParameter(document *project.Specification, param *project.Parameter, name string) MarkdownParameter {
+	object := MarkdownParameter{Name: name, Type: getTypeOf(param.Schema), Format: getFormat(param.Schema),
+		Default: utils.GetText(param.DefaultValue), Env: document.GetEnv(param), Required: param.IsRequired(), Constraints: getConstraints(param.Schema),
+		Deprecated: getDeprecation(param.Deprecated, getReplacementFormat(param))}
+
+	if param.ShortForm != nil {
+		object.ShortForm = "-" + *param.ShortForm
+	}
+
+	return object
+}
+
+// toMarkdownAnchor returns the anchor which GitHub generates for a heading
+func toMarkdownAnchor(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' {
+			return '-'
+		}
+
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' {
+			return unicode.ToLower(r)
+		}
+
+		return -1
+	}, value)
+}
+
+// toMarkdownCell escapes the text, so that it fits into a table cell
+func toMarkdownCell(value string) string {
+	return strings.ReplaceAll(strings.Join(strings.Fields(value), " "), "|", `\|`)
+}
@@ -0,0 +1,134 @@
+package export
+
+import (
+	"github.com/raitonbl/ant/internal"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestMarkdown_from_yaml(t *testing.T) {
+	ctx, err := internal.GetContext("../lint/testdata/index-003.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	binary, err := Markdown(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txt := string(binary)
+
+	assert.True(t, strings.HasPrefix(txt, "# cli 1.0.0\n"))
+	assert.True(t, strings.Contains(txt, "- [build](#cli-build) - allows to build the cli project for coding or testing\n  - [stack](#cli-build-stack)"))
+	assert.True(t, strings.Contains(txt, "## cli build stack\n"))
+	assert.True(t, strings.Contains(txt, "### Usage\n\n```sh\ncli build stack <filename> [--stack <string>]\n```\n"))
+	assert.True(t, strings.Contains(txt, "| `--stack` |  | string |  |  | no | one of java, python3, golang<br>e.g. java |\n"))
+	assert.True(t, strings.Contains(txt, "| 2 | Input file not found |  |\n"))
+}
+
+func TestMarkdownFiles_from_yaml(t *testing.T) {
+	ctx, err := internal.GetContext("../lint/testdata/index-067.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := MarkdownFiles(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, files, 3)
+	assert.True(t, strings.Contains(string(files[MarkdownIndex]), "- [build](cli-build.md) - builds the cli project\n  - [stack](cli-build-stack.md)"))
+	assert.True(t, strings.Contains(string(files["cli-build.md"]), "| [stack](cli-build-stack.md) | builds the project of the user's stack |"))
+
+	txt := string(files["cli-build-stack.md"])
+
+	assert.True(t, strings.HasPrefix(txt, "# cli build stack\n"))
+	assert.True(t, strings.Contains(txt, "## Arguments\n\n| Name | Short form | Type | Format | Default | Required | Constraints |\n"))
+	assert.True(t, strings.Contains(txt, "| `target` |  | string |  |  | yes | one of project, tests |\n"))
+	assert.True(t, strings.Contains(txt, "| `--language` | `-l` | string |  |  | no | one of java, golang |\n"))
+}
+
+func TestMarkdown_where_deprecated(t *testing.T) {
+	ctx, err := internal.GetContext("../lint/testdata/index-068.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	binary, err := Markdown(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txt := string(binary)
+
+	assert.True(t, strings.Contains(txt, "- [build](#cli-build) _(deprecated)_ - allows to build the cli project\n"))
+	assert.True(t, strings.Contains(txt, "## cli build\n\nallows to build the cli project\n\n> **Deprecated since 1.1.0, use cli compile instead.**\n"))
+	assert.True(t, strings.Contains(txt, "| `--output`<br>_Deprecated since 1.1.0, use --target instead._ | `-o` | string |  |  | no |  |\n"))
+	assert.True(t, strings.Contains(txt, "| `--verbose`<br>_Deprecated._ |  | boolean |  |  | no |  |\n"))
+}
+
+func TestMarkdown_where_command_is_hidden(t *testing.T) {
+	ctx, err := internal.GetContext("../lint/testdata/index-071.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	binary, err := Markdown(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txt := string(binary)
+
+	assert.True(t, strings.Contains(txt, "## cli build\n\nallows to build the cli project\n\nAliases: `b`\n"))
+	assert.False(t, strings.Contains(txt, "debug"))
+	assert.False(t, strings.Contains(txt, "image"))
+}
+
+func TestMarkdown_where_flag_is_bound_to_env(t *testing.T) {
+	ctx, err := internal.GetContext("../lint/testdata/index-073.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	binary, err := Markdown(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txt := string(binary)
+
+	assert.True(t, strings.Contains(txt, "| `--config` | `-c` | string |  | `$CLI_CONFIG`, else `cli.yaml` | no |  |\n"))
+	assert.True(t, strings.Contains(txt, "| `--token` |  | string |  | `$CLI_TOKEN` | no |  |\n"))
+}
+
+func TestMarkdown_where_argument_is_variadic(t *testing.T) {
+	ctx, err := internal.GetContext("../lint/testdata/index-075.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	binary, err := Markdown(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txt := string(binary)
+
+	assert.True(t, strings.Contains(txt, "```sh\ncli remove <directory> <files...>\n```\n"))
+}
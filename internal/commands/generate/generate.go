@@ -0,0 +1,48 @@
+package generate
+
+import (
+	"github.com/raitonbl/ant/internal"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+func toIdentifier(words ...string) string {
+	txt := ""
+
+	for _, word := range words {
+		for _, each := range strings.FieldsFunc(strings.ReplaceAll(word, "'", ""), isNotAlphanumeric) {
+			runes := []rune(each)
+			txt += string(unicode.ToUpper(runes[0])) + string(runes[1:])
+		}
+	}
+
+	if txt != "" && unicode.IsDigit([]rune(txt)[0]) {
+		txt = "X" + txt
+	}
+
+	return txt
+}
+
+func isNotAlphanumeric(value rune) bool {
+	return !unicode.IsLetter(value) && !unicode.IsDigit(value)
+}
+
+func writeFile(directory string, filename string, binary []byte, overwrite bool) error {
+	path := filepath.Join(directory, filename)
+
+	if _, err := os.Stat(path); err == nil && !overwrite {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return internal.GetProblemFactory().GetFileCannotBeWritten(path, err)
+	}
+
+	if err := os.WriteFile(path, binary, 0644); err != nil {
+		return internal.GetProblemFactory().GetFileCannotBeWritten(path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,243 @@
+package generate
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/internal/utils"
+	"github.com/raitonbl/ant/pkg/project"
+	"go/format"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+var (
+	//go:embed templates/golang
+	golangTemplates embed.FS
+)
+
+type GolangProject struct {
+	Source      string
+	Module      string
+	Name        string
+	Version     string
+	Description string
+	Commands    []GolangCommand
+	Exit        []GolangExit
+}
+
+type GolangCommand struct {
+	Name        string
+	Path        string
+	Action      string
+	Executable  string
+	Description string
+	Arguments   []GolangArgument
+	Flags       []GolangFlag
+}
+
+type GolangArgument struct {
+	Name         string
+	Description  string
+	DefaultValue string
+}
+
+type GolangFlag struct {
+	Names        string
+	Description  string
+	DataType     string
+	DefaultValue string
+}
+
+type GolangExit struct {
+	Name    string
+	Code    int
+	Message string
+}
+
+func Golang(ctx internal.ProjectContext, directory string) error {
+
+	document, err := internal.GetDocument(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	object := newGolangProject(filepath.Base(ctx.GetProjectFile().GetName()), document)
+
+	tmpl, err := template.New("golang").Funcs(template.FuncMap{"quote": strconv.Quote}).ParseFS(golangTemplates, "templates/golang/*.tmpl")
+
+	if err != nil {
+		return internal.GetProblemFactory().GetProblem(err)
+	}
+
+	if err = doWriteGolangFile(tmpl, directory, "go.mod", "go.mod.tmpl", object, false); err != nil {
+		return err
+	}
+
+	if err = doWriteGolangFile(tmpl, directory, "go.sum", "go.sum.tmpl", object, false); err != nil {
+		return err
+	}
+
+	if err = doWriteGolangFile(tmpl, directory, "main.go", "main.go.tmpl", object, true); err != nil {
+		return err
+	}
+
+	if err = doWriteGolangFile(tmpl, directory, "exit.go", "exit.go.tmpl", object, true); err != nil {
+		return err
+	}
+
+	for _, command := range object.Commands {
+		filename := fmt.Sprintf("%s_action.go", strings.ReplaceAll(command.Name, "-", "_"))
+
+		if err = doWriteGolangFile(tmpl, directory, filename, "action.go.tmpl", command, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func doWriteGolangFile(tmpl *template.Template, directory string, filename string, name string, data interface{}, overwrite bool) error {
+	buffer := &bytes.Buffer{}
+
+	if err := tmpl.ExecuteTemplate(buffer, name, data); err != nil {
+		return internal.GetProblemFactory().GetProblem(err)
+	}
+
+	binary := buffer.Bytes()
+
+	if strings.HasSuffix(filename, ".go") {
+		formatted, err := format.Source(binary)
+
+		if err != nil {
+			return internal.GetProblemFactory().GetProblem(err)
+		}
+
+		binary = formatted
+	}
+
+	return writeFile(directory, filename, binary, overwrite)
+}
+
+func newGolangProject(source string, document *project.Specification) *GolangProject {
+	name := utils.GetText(document.Name)
+	object := &GolangProject{Source: source, Name: name, Module: strings.ToLower(strings.Join(strings.FieldsFunc(name, isNotAlphanumeric), "-")),
+		Version: utils.GetText(document.Version), Description: utils.GetText(document.Description), Commands: make([]GolangCommand, 0)}
+
+	exitCache := make(map[string]int)
+
+	for _, each := range document.Exit {
+		object.Exit = doAddGolangExit(object.Exit, exitCache, toIdentifier("exit", utils.GetText(each.Id)), &each)
+	}
+
+	for _, leaf := range document.GetLeafCommands() {
+		command := GolangCommand{Name: strings.Join(leaf.Path, "-"), Path: strings.Join(leaf.Path, " "), Executable: name,
+			Action: "do" + toIdentifier(leaf.Path...), Description: utils.GetText(leaf.Command.Description)}
+
+		for _, each := range leaf.GetArguments() {
+			param := each.Parameter
+			argument := GolangArgument{Name: utils.GetText(param.Name), Description: utils.GetText(param.Description), DefaultValue: strconv.Quote(utils.GetText(param.DefaultValue))}
+
+			// commando binds every remaining value to an argument which name ends with ...
+			if param.IsVariadic() {
+				argument.Name += "..."
+			} else if param.DefaultValue == nil && !param.IsRequired() {
+				argument.DefaultValue = "unset"
+			}
+
+			command.Arguments = append(command.Arguments, argument)
+		}
+
+		for _, each := range leaf.GetFlags() {
+			command.Flags = append(command.Flags, newGolangFlag(each.Parameter))
+		}
+
+		for _, each := range leaf.Exit {
+			if each.RefersTo == "" {
+				object.Exit = doAddGolangExit(object.Exit, exitCache, toIdentifier(append(append([]string{"exit"}, leaf.Path...), utils.GetText(each.Exit.Message))...), each.Exit)
+			} else {
+				object.Exit = doAddGolangExit(object.Exit, exitCache, toIdentifier("exit", utils.GetText(each.Exit.Id)), each.Exit)
+			}
+		}
+
+		object.Commands = append(object.Commands, command)
+	}
+
+	return object
+}
+
+func newGolangFlag(param *project.Parameter) GolangFlag {
+	flag := GolangFlag{Names: utils.GetText(param.Name), Description: utils.GetText(param.Description), DataType: "commando.String", DefaultValue: "nil"}
+
+	if param.ShortForm != nil {
+		flag.Names = fmt.Sprintf("%s,%s", flag.Names, *param.ShortForm)
+	}
+
+	typeOf := project.String
+	var formatOf project.SchemaFormat
+
+	if param.Schema != nil && param.Schema.TypeOf != nil {
+		typeOf = *param.Schema.TypeOf
+	}
+
+	if param.Schema != nil && param.Schema.Format != nil {
+		formatOf = *param.Schema.Format
+	}
+
+	if typeOf == project.Bool {
+		flag.DataType = "commando.Bool"
+	} else if typeOf == project.Number && (formatOf == project.Int32 || formatOf == project.Int64) {
+		flag.DataType = "commando.Int"
+	}
+
+	if flag.DataType == "commando.Bool" {
+		return flag
+	}
+
+	// commando requires a flag which default is nil, hence an optional flag without a default is given a zero value
+	if param.DefaultValue == nil && !param.IsRequired() {
+		flag.DefaultValue = "unset"
+
+		if flag.DataType == "commando.Int" {
+			flag.DefaultValue = "0"
+		}
+
+		return flag
+	}
+
+	if param.DefaultValue == nil {
+		return flag
+	}
+
+	if flag.DataType == "commando.Int" {
+		if _, err := strconv.Atoi(*param.DefaultValue); err == nil {
+			flag.DefaultValue = *param.DefaultValue
+		}
+		return flag
+	}
+
+	flag.DefaultValue = strconv.Quote(*param.DefaultValue)
+
+	return flag
+}
+
+func doAddGolangExit(array []GolangExit, cache map[string]int, name string, exit *project.Exit) []GolangExit {
+
+	if exit.Code == nil {
+		return array
+	}
+
+	if code, isPresent := cache[name]; isPresent && code == *exit.Code {
+		return array
+	} else if isPresent {
+		name = fmt.Sprintf("%s%d", name, *exit.Code)
+	}
+
+	cache[name] = *exit.Code
+
+	return append(array, GolangExit{Name: name, Code: *exit.Code, Message: strings.Join(strings.Fields(utils.GetText(exit.Message)), " ")})
+}
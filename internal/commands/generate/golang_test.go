@@ -0,0 +1,112 @@
+package generate
+
+import (
+	"github.com/raitonbl/ant/internal"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGolang_from_yaml(t *testing.T) {
+	directory := doGenerateGolang(t, "../lint/testdata/index-003.yaml")
+
+	for _, filename := range []string{"go.mod", "go.sum", "main.go", "exit.go", "lint_action.go", "build_stack_action.go", "build_test_action.go"} {
+		if _, err := os.Stat(filepath.Join(directory, filename)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	main := doReadFile(t, directory, "main.go")
+	assert.True(t, strings.Contains(main, `registry.Register("build-stack")`))
+	assert.True(t, strings.Contains(main, `AddArgument("filename", "indicates the specification.yaml which will be ingested", "")`))
+	assert.True(t, strings.Contains(main, `AddFlag("stack", "indicates the programming language which is used to generate the project", commando.String, unset)`))
+	assert.True(t, strings.Contains(main, "SetAction(doBuildStack)"))
+
+	exit := doReadFile(t, directory, "exit.go")
+	assert.True(t, strings.Contains(exit, "ExitFileNotFound = 2"))
+	assert.True(t, strings.Contains(exit, "ExitLintUnexpectedBehaviour = 1"))
+}
+
+func TestGolang_where_action_exists(t *testing.T) {
+	directory := doGenerateGolang(t, "../lint/testdata/index-003.yaml")
+
+	if err := os.WriteFile(filepath.Join(directory, "lint_action.go"), []byte("package main\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, err := internal.GetContext("../lint/testdata/index-003.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = Golang(ctx, directory); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "package main\n", doReadFile(t, directory, "lint_action.go"))
+}
+
+func TestGolang_where_arguments_are_sorted_by_index(t *testing.T) {
+	directory := doGenerateGolang(t, "../lint/testdata/index-058.yaml")
+	main := doReadFile(t, directory, "main.go")
+	main = main[strings.Index(main, `registry.Register("build-test")`):]
+
+	assert.True(t, strings.Index(main, `AddArgument("filename"`) < strings.Index(main, `AddArgument("arg1"`))
+}
+
+func TestGolang_where_argument_is_variadic(t *testing.T) {
+	directory := doGenerateGolang(t, "../lint/testdata/index-075.yaml")
+	main := doReadFile(t, directory, "main.go")
+
+	assert.True(t, strings.Contains(main, `AddArgument("files...", "indicates the files which are removed", "")`))
+}
+
+func TestGolang_where_optional_flag_is_left_out(t *testing.T) {
+	binary, err := exec.LookPath("go")
+
+	if err != nil {
+		t.Skip("go isn't available")
+	}
+
+	directory := doGenerateGolang(t, "../lint/testdata/index-003.yaml")
+	command := exec.Command(binary, "run", ".", "build", "stack", "index.yaml")
+	command.Dir = directory
+	output, _ := command.CombinedOutput()
+
+	assert.Contains(t, string(output), "build stack: not implemented")
+}
+
+func TestToIdentifier(t *testing.T) {
+	assert.Equal(t, "ExitFileNotFound", toIdentifier("exit", "file-not-found"))
+	assert.Equal(t, "DocumentIsntValid", toIdentifier("Document isn't valid"))
+	assert.Equal(t, "X1st", toIdentifier("1st"))
+}
+
+func doGenerateGolang(t *testing.T, filename string) string {
+	directory := t.TempDir()
+	ctx, err := internal.GetContext(filename)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = Golang(ctx, directory); err != nil {
+		t.Fatal(err)
+	}
+
+	return directory
+}
+
+func doReadFile(t *testing.T, directory string, filename string) string {
+	binary, err := os.ReadFile(filepath.Join(directory, filename))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(binary)
+}
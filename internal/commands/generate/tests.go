@@ -0,0 +1,324 @@
+package generate
+
+import (
+	"embed"
+	"fmt"
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/internal/utils"
+	"github.com/raitonbl/ant/pkg/project"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+var (
+	//go:embed templates/tests
+	testTemplates embed.FS
+)
+
+type TestProject struct {
+	Source   string
+	Module   string
+	Binary   string
+	Commands []TestCommand
+}
+
+type TestCommand struct {
+	Name  string
+	Path  string
+	Exit  []int
+	Cases []TestCase
+}
+
+type TestCase struct {
+	Name  string
+	Args  []string
+	Valid bool
+}
+
+type TestValue struct {
+	Name  string
+	Value string
+	Valid bool
+}
+
+func Tests(ctx internal.ProjectContext, binary string, directory string) error {
+
+	document, err := internal.GetDocument(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	object := newTestProject(filepath.Base(ctx.GetProjectFile().GetName()), binary, document)
+
+	tmpl, err := template.New("tests").Funcs(template.FuncMap{"quote": strconv.Quote}).ParseFS(testTemplates, "templates/tests/*.tmpl")
+
+	if err != nil {
+		return internal.GetProblemFactory().GetProblem(err)
+	}
+
+	if err = doWriteGolangFile(tmpl, directory, "go.mod", "go.mod.tmpl", object, false); err != nil {
+		return err
+	}
+
+	return doWriteGolangFile(tmpl, directory, "cli_test.go", "cli_test.go.tmpl", object, true)
+}
+
+func newTestProject(source string, binary string, document *project.Specification) *TestProject {
+	name := utils.GetText(document.Name)
+	object := &TestProject{Source: source, Binary: binary, Module: strings.ToLower(strings.Join(append(strings.FieldsFunc(name, isNotAlphanumeric), "tests"), "-")),
+		Commands: make([]TestCommand, 0)}
+
+	for _, leaf := range document.GetLeafCommands() {
+		command := TestCommand{Name: "Test" + toIdentifier(leaf.Path...), Path: strings.Join(leaf.Path, " "), Exit: make([]int, 0)}
+
+		for _, each := range leaf.Exit {
+			if each.Exit.Code != nil {
+				command.Exit = append(command.Exit, *each.Exit.Code)
+			}
+		}
+
+		command.Cases = newTestCases(leaf.Path, leaf.GetParameters())
+		object.Commands = append(object.Commands, command)
+	}
+
+	return object
+}
+
+func newTestCases(path []string, parameters []*project.Parameter) []TestCase {
+	arguments := make([]*project.Parameter, 0)
+	flags := make([]*project.Parameter, 0)
+
+	for _, param := range parameters {
+		if param.IsArgument() {
+			arguments = append(arguments, param)
+		} else {
+			flags = append(flags, param)
+		}
+	}
+
+	sort.SliceStable(arguments, func(i, j int) bool {
+		return arguments[i].GetIndex() < arguments[j].GetIndex()
+	})
+
+	values := make(map[*project.Parameter]string)
+
+	for _, param := range parameters {
+		values[param] = getTestValue(param)
+	}
+
+	array := []TestCase{{Name: "default", Args: doGetTestArgs(path, arguments, flags, values, nil), Valid: true}}
+
+	for _, param := range parameters {
+		for _, each := range getTestValues(param) {
+			copyOf := make(map[*project.Parameter]string)
+
+			for key, value := range values {
+				copyOf[key] = value
+			}
+
+			copyOf[param] = each.Value
+			array = append(array, TestCase{Name: fmt.Sprintf("%s=%s", utils.GetText(param.Name), each.Name), Args: doGetTestArgs(path, arguments, flags, copyOf, param), Valid: each.Valid})
+		}
+
+		if param.In != nil && *param.In == project.Arguments && param.DefaultValue == nil && !param.IsVariadic() {
+			array = append(array, TestCase{Name: fmt.Sprintf("%s=missing", utils.GetText(param.Name)), Args: doGetTestArgs(path, arguments[:indexOf(arguments, param)], nil, values, nil), Valid: false})
+		}
+
+		if (param.In == nil || *param.In == project.Flags) && param.Required != nil && *param.Required {
+			array = append(array, TestCase{Name: fmt.Sprintf("%s=missing", utils.GetText(param.Name)), Args: doGetTestArgs(path, arguments, without(flags, param), values, nil), Valid: false})
+		}
+	}
+
+	return array
+}
+
+func doGetTestArgs(path []string, arguments []*project.Parameter, flags []*project.Parameter, values map[*project.Parameter]string, target *project.Parameter) []string {
+	args := append(make([]string, 0), path...)
+
+	for _, param := range arguments {
+		args = append(args, values[param])
+	}
+
+	for _, param := range flags {
+		isRequired := param.Required != nil && *param.Required
+
+		if !isRequired && param != target {
+			continue
+		}
+
+		if isBoolean(param.Schema) {
+			args = append(args, fmt.Sprintf("--%s", utils.GetText(param.Name)))
+		} else {
+			args = append(args, fmt.Sprintf("--%s", utils.GetText(param.Name)), values[param])
+		}
+	}
+
+	return args
+}
+
+func getTestValue(param *project.Parameter) string {
+
+	if param.DefaultValue != nil {
+		return *param.DefaultValue
+	}
+
+	schema := param.Schema
+
+	if schema != nil && schema.TypeOf != nil && *schema.TypeOf == project.Array && schema.Items != nil {
+		schema = schema.Items
+	}
+
+	if schema == nil {
+		return "value"
+	}
+
+	if len(schema.Examples) > 0 {
+		return schema.Examples[0]
+	}
+
+	if len(schema.Enum) > 0 {
+		return schema.Enum[0]
+	}
+
+	if schema.TypeOf != nil && *schema.TypeOf == project.Number {
+		return strconv.Itoa(getMinimum(schema))
+	}
+
+	if isBoolean(schema) {
+		return "true"
+	}
+
+	if schema.Format != nil && *schema.Format == project.Date {
+		return "2006-01-02"
+	}
+
+	if schema.Format != nil && *schema.Format == project.DateTime {
+		return "2006-01-02T15:04:05Z"
+	}
+
+	size := 5
+
+	if schema.MinLength != nil && *schema.MinLength > size {
+		size = *schema.MinLength
+	}
+
+	if schema.MaxLength != nil && *schema.MaxLength < size {
+		size = *schema.MaxLength
+	}
+
+	return strings.Repeat("a", size)
+}
+
+func getTestValues(param *project.Parameter) []TestValue {
+	schema := param.Schema
+	array := make([]TestValue, 0)
+
+	if schema == nil || schema.TypeOf == nil || isBoolean(schema) {
+		return array
+	}
+
+	for _, each := range schema.Enum {
+		array = append(array, TestValue{Name: each, Value: each, Valid: true})
+	}
+
+	for index, each := range schema.Examples {
+		array = append(array, TestValue{Name: fmt.Sprintf("example-%d", index), Value: each, Valid: true})
+	}
+
+	if len(schema.Enum) > 0 {
+		array = append(array, TestValue{Name: "not-in-enum", Value: "not-in-enum", Valid: false})
+	}
+
+	switch *schema.TypeOf {
+	case project.Number:
+		array = append(array, getNumberTestValues(schema)...)
+	case project.String:
+		array = append(array, getTextTestValues(schema)...)
+	}
+
+	return array
+}
+
+func getNumberTestValues(schema *project.Schema) []TestValue {
+	array := []TestValue{{Name: "not-a-number", Value: "not-a-number", Valid: false}}
+
+	if schema.Minimum != nil {
+		array = append(array, TestValue{Name: "minimum", Value: strconv.Itoa(getMinimum(schema)), Valid: true})
+		array = append(array, TestValue{Name: "lt-minimum", Value: strconv.Itoa(getMinimum(schema) - 1), Valid: false})
+	}
+
+	if schema.Maximum != nil {
+		maximum := *schema.Maximum
+
+		if schema.ExclusiveMaximum != nil && *schema.ExclusiveMaximum {
+			maximum = maximum - 1
+		}
+
+		array = append(array, TestValue{Name: "maximum", Value: strconv.Itoa(maximum), Valid: true})
+		array = append(array, TestValue{Name: "gt-maximum", Value: strconv.Itoa(maximum + 1), Valid: false})
+	}
+
+	return array
+}
+
+func getTextTestValues(schema *project.Schema) []TestValue {
+	array := make([]TestValue, 0)
+
+	if schema.MinLength != nil && *schema.MinLength > 0 {
+		array = append(array, TestValue{Name: "min-length", Value: strings.Repeat("a", *schema.MinLength), Valid: true})
+		array = append(array, TestValue{Name: "lt-min-length", Value: strings.Repeat("a", *schema.MinLength-1), Valid: false})
+	}
+
+	if schema.MaxLength != nil {
+		array = append(array, TestValue{Name: "max-length", Value: strings.Repeat("a", *schema.MaxLength), Valid: true})
+		array = append(array, TestValue{Name: "gt-max-length", Value: strings.Repeat("a", *schema.MaxLength+1), Valid: false})
+	}
+
+	if schema.Format != nil && (*schema.Format == project.Date || *schema.Format == project.DateTime) {
+		array = append(array, TestValue{Name: fmt.Sprintf("not-a-%s", *schema.Format), Value: "not-a-date", Valid: false})
+	}
+
+	return array
+}
+
+func getMinimum(schema *project.Schema) int {
+
+	if schema.Minimum == nil {
+		return 0
+	}
+
+	if schema.ExclusiveMinimum != nil && *schema.ExclusiveMinimum {
+		return *schema.Minimum + 1
+	}
+
+	return *schema.Minimum
+}
+
+func isBoolean(schema *project.Schema) bool {
+	return schema != nil && schema.TypeOf != nil && *schema.TypeOf == project.Bool
+}
+
+func indexOf(array []*project.Parameter, param *project.Parameter) int {
+	for index, each := range array {
+		if each == param {
+			return index
+		}
+	}
+	return len(array)
+}
+
+func without(array []*project.Parameter, param *project.Parameter) []*project.Parameter {
+	seq := make([]*project.Parameter, 0)
+
+	for _, each := range array {
+		if each != param {
+			seq = append(seq, each)
+		}
+	}
+
+	return seq
+}
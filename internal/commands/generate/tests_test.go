@@ -0,0 +1,57 @@
+package generate
+
+import (
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/pkg/project"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestTests_from_yaml(t *testing.T) {
+	directory := t.TempDir()
+	ctx, err := internal.GetContext("../lint/testdata/index-003.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = Tests(ctx, "/usr/local/bin/cli", directory); err != nil {
+		t.Fatal(err)
+	}
+
+	txt := doReadFile(t, directory, "cli_test.go")
+	assert.True(t, strings.Contains(txt, `return "/usr/local/bin/cli"`))
+	assert.True(t, strings.Contains(txt, `doTest(t, []int{1, 2}, []testCase{`))
+	assert.True(t, strings.Contains(txt, `{name: "stack=not-in-enum", args: []string{"build", "stack", "aaaaa", "--stack", "not-in-enum"}, valid: false}`))
+	assert.True(t, strings.Contains(txt, `{name: "filename=missing", args: []string{"lint"}, valid: false}`))
+	assert.True(t, strings.Contains(txt, "if each.valid && code != 0 {"))
+}
+
+func TestGetTestValues_where_type_is_number(t *testing.T) {
+	minimum, maximum, exclusive := 1, 10, true
+	typeOf := project.Number
+	param := &project.Parameter{Schema: &project.Schema{TypeOf: &typeOf, Minimum: &minimum, Maximum: &maximum, ExclusiveMaximum: &exclusive}}
+
+	assert.Equal(t, []TestValue{
+		{Name: "not-a-number", Value: "not-a-number", Valid: false},
+		{Name: "minimum", Value: "1", Valid: true},
+		{Name: "lt-minimum", Value: "0", Valid: false},
+		{Name: "maximum", Value: "9", Valid: true},
+		{Name: "gt-maximum", Value: "10", Valid: false},
+	}, getTestValues(param))
+}
+
+func TestGetTestValues_where_type_is_string(t *testing.T) {
+	minimum, maximum := 2, 3
+	typeOf := project.String
+	param := &project.Parameter{Schema: &project.Schema{TypeOf: &typeOf, MinLength: &minimum, MaxLength: &maximum}}
+
+	assert.Equal(t, []TestValue{
+		{Name: "min-length", Value: "aa", Valid: true},
+		{Name: "lt-min-length", Value: "a", Valid: false},
+		{Name: "max-length", Value: "aaa", Valid: true},
+		{Name: "gt-max-length", Value: "aaaa", Valid: false},
+	}, getTestValues(param))
+	assert.Equal(t, "aaa", getTestValue(param))
+}
@@ -2,12 +2,13 @@ package lint
 
 import (
 	"fmt"
-	"github.com/raitonbl/ant/internal/commands/lint/lint_message"
-	"github.com/raitonbl/ant/internal/project"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_rule"
 	"github.com/raitonbl/ant/internal/utils"
+	"github.com/raitonbl/ant/pkg/project"
+	"strings"
 )
 
-func doLintCommandSection(document *project.Specification, parameterCache map[string]*project.Parameter, exitCache map[string]*project.Exit, schemas map[string]*project.Schema) ([]Violation, error) {
+func doLintCommandSection(document *project.Specification, schemas map[string]*project.Schema) ([]Violation, error) {
 	problems := make([]Violation, 0)
 	cache := make(map[string]*project.Command)
 
@@ -15,8 +16,17 @@ func doLintCommandSection(document *project.Specification, parameterCache map[st
 		return problems, nil
 	}
 
+	commands := make([]*project.Command, 0, len(document.Subcommands))
+
+	for index := range document.Subcommands {
+		commands = append(commands, &document.Subcommands[index])
+	}
+
+	problems = append(problems, doLintCommandAliases("/commands", commands)...)
+
 	for index, command := range document.Subcommands {
-		ctx := &CommandLintingContext{path: fmt.Sprintf("/commands/%d", index), parameterCache: parameterCache, exitCache: exitCache, schemaCache: schemas, commandCache: cache}
+		ctx := &CommandLintingContext{path: fmt.Sprintf("/commands/%d", index), names: []string{command.GetName()}, document: document,
+			schemaCache: schemas, commandCache: cache}
 
 		v, prob := doLintCommand(ctx, &command, document)
 
@@ -37,35 +47,42 @@ func doLintCommand(commandContext *CommandLintingContext, instance *project.Comm
 	problems := make([]Violation, 0)
 
 	if instance.Id != nil && utils.IsBlank(*instance.Id) {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/id", prefix), Message: lint_message.BLANK_FIELD})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/id", prefix), lint_rule.BLANK_COMMAND_ID))
 	}
 
 	if instance.Id != nil && cache[*instance.Id] != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/id", prefix), Message: lint_message.DUPLICATED_FIELD_VALUE})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/id", prefix), lint_rule.DUPLICATED_ID))
 	}
 
 	if instance.Name == nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf(name_format_pattern, prefix), Message: lint_message.REQUIRED_FIELD})
+		problems = append(problems, newViolation(fmt.Sprintf(name_format_pattern, prefix), lint_rule.REQUIRED_COMMAND_NAME))
 	}
 
 	if instance.Name != nil && utils.IsBlank(*instance.Name) {
-		problems = append(problems, Violation{Path: fmt.Sprintf(name_format_pattern, prefix), Message: lint_message.BLANK_FIELD})
+		problems = append(problems, newViolation(fmt.Sprintf(name_format_pattern, prefix), lint_rule.BLANK_COMMAND_NAME))
 	}
 
 	if instance.Description == nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/description", prefix), Message: lint_message.REQUIRED_FIELD})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/description", prefix), lint_rule.REQUIRED_COMMAND_DESCRIPTION))
 	}
 
 	if instance.Description != nil && utils.IsBlank(*instance.Description) {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/description", prefix), Message: lint_message.BLANK_FIELD})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/description", prefix), lint_rule.BLANK_COMMAND_DESCRIPTION))
+	}
+
+	if instance.Deprecated != nil && instance.Deprecated.ReplacedBy != nil && document.GetCommand(strings.Fields(*instance.Deprecated.ReplacedBy)...) == nil {
+		problems = append(problems, newViolation(fmt.Sprintf(replaced_by_format_pattern, prefix), lint_rule.UNRESOLVABLE_REPLACEMENT))
+	} else if instance.Deprecated != nil && instance.Deprecated.ReplacedBy != nil &&
+		strings.Join(strings.Fields(*instance.Deprecated.ReplacedBy), " ") == strings.Join(commandContext.names, " ") {
+		problems = append(problems, newViolation(fmt.Sprintf(replaced_by_format_pattern, prefix), lint_rule.REPLACED_BY_ITSELF))
 	}
 
 	if instance.Subcommands != nil && instance.Exit != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/exit", prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/exit", prefix), lint_rule.EXIT_IN_PARENT_COMMAND))
 	}
 
 	if instance.Subcommands != nil && instance.Parameters != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/parameters", prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/parameters", prefix), lint_rule.PARAMETERS_IN_PARENT_COMMAND))
 	}
 
 	array, err := doLintCommandConfiguration(commandContext, instance, document)
@@ -97,6 +114,7 @@ func doLintCommandConfiguration(commandContext *CommandLintingContext, instance
 	}
 
 	problems = append(problems, array...)
+	problems = append(problems, doLintCommandConstraints(commandContext, document, instance)...)
 
 	if instance.Id != nil {
 		cache[*instance.Id] = instance
@@ -114,16 +132,17 @@ func doLintCommandConfiguration(commandContext *CommandLintingContext, instance
 func doLintSubcommands(commandContext *CommandLintingContext, document *project.Specification, instance *project.Command) ([]Violation, error) {
 	cache := commandContext.commandCache
 	prefix := commandContext.path
-	exitCache := commandContext.exitCache
 	schemaCache := commandContext.schemaCache
-	parameterCache := commandContext.parameterCache
 
 	problems := make([]Violation, 0)
 
 	if instance.Subcommands != nil {
+		problems = append(problems, doLintCommandAliases(fmt.Sprintf("%s/commands", prefix), instance.Subcommands)...)
+
 		for index, command := range instance.Subcommands {
 			path := fmt.Sprintf("%s/commands/%d", prefix, index)
-			ctx := &CommandLintingContext{path: path, parameterCache: parameterCache, exitCache: exitCache, schemaCache: schemaCache, commandCache: cache}
+			names := append(append(make([]string, 0), commandContext.names...), command.GetName())
+			ctx := &CommandLintingContext{path: path, names: names, document: document, schemaCache: schemaCache, commandCache: cache}
 			array, err := doLintCommand(ctx, command, document)
 
 			if err != nil {
@@ -135,3 +154,31 @@ func doLintSubcommands(commandContext *CommandLintingContext, document *project.
 
 	return problems, nil
 }
+
+// doLintCommandAliases verifies that each alias is neither the name nor the alias of another command at the same level
+func doLintCommandAliases(prefix string, commands []*project.Command) []Violation {
+	problems := make([]Violation, 0)
+	names := make(map[string]bool)
+
+	for _, each := range commands {
+		if each.Name != nil {
+			names[*each.Name] = true
+		}
+	}
+
+	for index, each := range commands {
+		for position, alias := range each.Aliases {
+			path := fmt.Sprintf("%s/%d/aliases/%d", prefix, index, position)
+
+			if utils.IsBlank(alias) {
+				problems = append(problems, newViolation(path, lint_rule.BLANK_ALIAS))
+			} else if names[alias] {
+				problems = append(problems, newViolation(path, lint_rule.ALIAS_IN_USE))
+			}
+
+			names[alias] = true
+		}
+	}
+
+	return problems
+}
@@ -2,19 +2,21 @@ package lint
 
 import (
 	"fmt"
-	"github.com/raitonbl/ant/internal/commands/lint/lint_message"
-	"github.com/raitonbl/ant/internal/project"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_rule"
 	"github.com/raitonbl/ant/internal/utils"
+	"github.com/raitonbl/ant/pkg/project"
 )
 
 const (
-	schema_format_pattern     = "%s/format"
-	minimum_format_pattern    = "%s/minimum"
-	min_length_format_pattern = "%s/min-length"
-	min_items_format_pattern  = "%s/min-items"
-	index_format_pattern      = "%s/index"
-	refers_to_format_pattern  = "%s/refers-to"
-	name_format_pattern       = "%s/name"
+	schema_format_pattern      = "%s/format"
+	minimum_format_pattern     = "%s/minimum"
+	min_length_format_pattern  = "%s/min-length"
+	min_items_format_pattern   = "%s/min-items"
+	index_format_pattern       = "%s/index"
+	refers_to_format_pattern   = "%s/refers-to"
+	name_format_pattern        = "%s/name"
+	replaced_by_format_pattern = "%s/deprecated/replaced-by"
+	env_format_pattern         = "%s/env"
 )
 
 type LintContext struct {
@@ -32,17 +34,17 @@ func doLintSchema(ctx *LintContext, schema *project.Schema) []Violation {
 	}
 
 	if schema.RefersTo != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf(refers_to_format_pattern, ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+		problems = append(problems, newViolation(fmt.Sprintf(refers_to_format_pattern, ctx.prefix), lint_rule.REFERS_TO_WITH_DEFINITION))
 	}
 
 	typeOf := *schema.TypeOf
 
 	if typeOf != project.String && schema.Format != nil && (*schema.Format == project.Date || *schema.Format == project.DateTime || *schema.Format == project.Binary) {
-		problems = append(problems, Violation{Path: fmt.Sprintf(schema_format_pattern, ctx.prefix), Message: lint_message.FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_STRING})
+		problems = append(problems, newViolation(fmt.Sprintf(schema_format_pattern, ctx.prefix), lint_rule.FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_STRING))
 	}
 
 	if typeOf == project.String && schema.Format != nil && !(*schema.Format == project.Date || *schema.Format == project.DateTime || *schema.Format == project.Binary) {
-		problems = append(problems, Violation{Path: fmt.Sprintf(schema_format_pattern, ctx.prefix), Message: lint_message.FIELD_FORMAT_NOT_ALLOWED_IN_TYPE_STRING})
+		problems = append(problems, newViolation(fmt.Sprintf(schema_format_pattern, ctx.prefix), lint_rule.FIELD_FORMAT_NOT_ALLOWED_IN_TYPE_STRING))
 	}
 
 	problems = append(problems, doLintTextSchema(ctx, schema, typeOf)...)
@@ -52,7 +54,7 @@ func doLintSchema(ctx *LintContext, schema *project.Schema) []Violation {
 	if schema.Enum != nil && schema.Examples != nil && len(schema.Examples) > 0 {
 		for i, example := range schema.Examples {
 			if !belongsTo(schema.Enum, example) {
-				problems = append(problems, Violation{Path: fmt.Sprintf("%s/examples/%d", ctx.prefix, i), Message: lint_message.FIELD_EXAMPLE_MUST_BE_PART_OF_ENUM})
+				problems = append(problems, newViolation(fmt.Sprintf("%s/examples/%d", ctx.prefix, i), lint_rule.FIELD_EXAMPLE_MUST_BE_PART_OF_ENUM))
 			}
 		}
 	}
@@ -64,13 +66,13 @@ func doLintSchemaRefersTo(ctx *LintContext, schema *project.Schema) ([]Violation
 	problems := make([]Violation, 0)
 
 	if schema.TypeOf == nil && schema.RefersTo == nil {
-		return []Violation{{Path: fmt.Sprintf("%s/type", ctx.prefix), Message: lint_message.REQUIRED_FIELD}}, true
+		return []Violation{newViolation(fmt.Sprintf("%s/type", ctx.prefix), lint_rule.REQUIRED_SCHEMA_TYPE)}, true
 	} else if schema.TypeOf == nil && schema.RefersTo != nil {
 
-		fromCache := ctx.schemas[*schema.RefersTo]
+		fromCache := getSchema(ctx, *schema.RefersTo)
 
 		if fromCache == nil {
-			problems = append(problems, Violation{Path: fmt.Sprintf(refers_to_format_pattern, ctx.prefix), Message: lint_message.UNRESOLVABLE_FIELD})
+			problems = append(problems, newViolation(fmt.Sprintf(refers_to_format_pattern, ctx.prefix), lint_rule.UNRESOLVABLE_REFERENCE))
 		}
 
 		return problems, true
@@ -84,15 +86,15 @@ func doLintTextSchema(ctx *LintContext, schema *project.Schema, typeOf project.S
 
 	if typeOf != project.String {
 		if schema.MaxLength != nil {
-			problems = append(problems, Violation{Path: fmt.Sprintf("%s/max-length", ctx.prefix), Message: lint_message.FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_STRING})
+			problems = append(problems, newViolation(fmt.Sprintf("%s/max-length", ctx.prefix), lint_rule.FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_STRING))
 		}
 
 		if schema.MinLength != nil {
-			problems = append(problems, Violation{Path: fmt.Sprintf(min_length_format_pattern, ctx.prefix), Message: lint_message.FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_STRING})
+			problems = append(problems, newViolation(fmt.Sprintf(min_length_format_pattern, ctx.prefix), lint_rule.FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_STRING))
 		}
 
 		if schema.Pattern != nil {
-			problems = append(problems, Violation{Path: fmt.Sprintf("%s/pattern", ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+			problems = append(problems, newViolation(fmt.Sprintf("%s/pattern", ctx.prefix), lint_rule.PATTERN_IN_NON_STRING))
 		}
 
 	} else {
@@ -106,11 +108,11 @@ func doLintTextSchemaLength(ctx *LintContext, schema *project.Schema) []Violatio
 	problems := make([]Violation, 0)
 
 	if schema.MinLength != nil && *schema.MinLength < 0 {
-		problems = append(problems, Violation{Path: fmt.Sprintf(min_length_format_pattern, ctx.prefix), Message: lint_message.FIELD_MIN_LENGTH_GT_ZERO})
+		problems = append(problems, newViolation(fmt.Sprintf(min_length_format_pattern, ctx.prefix), lint_rule.FIELD_MIN_LENGTH_GT_ZERO))
 	}
 
 	if schema.MaxLength != nil && *schema.MaxLength < 0 {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/max-length", ctx.prefix), Message: lint_message.FIELD_MAX_LENGTH_GT_ZERO})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/max-length", ctx.prefix), lint_rule.FIELD_MAX_LENGTH_GT_ZERO))
 	}
 
 	if schema.MaxLength != nil && schema.MinLength != nil {
@@ -118,7 +120,7 @@ func doLintTextSchemaLength(ctx *LintContext, schema *project.Schema) []Violatio
 		minimum := *schema.MinLength
 
 		if minimum > maximum {
-			problems = append(problems, Violation{Path: fmt.Sprintf(min_length_format_pattern, ctx.prefix), Message: lint_message.FIELD_MIN_LENGTH_MUST_NOT_BE_GT_MAX_LENGTH})
+			problems = append(problems, newViolation(fmt.Sprintf(min_length_format_pattern, ctx.prefix), lint_rule.FIELD_MIN_LENGTH_MUST_NOT_BE_GT_MAX_LENGTH))
 		}
 	}
 	return problems
@@ -129,7 +131,7 @@ func doLintNumberSchema(ctx *LintContext, schema *project.Schema, typeOf project
 	problems := make([]Violation, 0)
 
 	if schema.MultipleOf != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/multiple-of", ctx.prefix), Message: lint_message.FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_NUMBER})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/multiple-of", ctx.prefix), lint_rule.FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_NUMBER))
 	}
 
 	if typeOf == project.Number {
@@ -138,17 +140,17 @@ func doLintNumberSchema(ctx *LintContext, schema *project.Schema, typeOf project
 			minimum := *schema.Minimum
 
 			if minimum > maximum {
-				problems = append(problems, Violation{Path: fmt.Sprintf(minimum_format_pattern, ctx.prefix), Message: lint_message.FIELD_MIN_MUST_NOT_BE_GT_MAX})
+				problems = append(problems, newViolation(fmt.Sprintf(minimum_format_pattern, ctx.prefix), lint_rule.FIELD_MIN_MUST_NOT_BE_GT_MAX))
 			}
 
 		}
 
 		if schema.Maximum == nil && schema.ExclusiveMaximum != nil && *schema.ExclusiveMaximum {
-			problems = append(problems, Violation{Path: fmt.Sprintf("%s/maximum", ctx.prefix), Message: lint_message.REQUIRED_FIELD})
+			problems = append(problems, newViolation(fmt.Sprintf("%s/maximum", ctx.prefix), lint_rule.EXCLUSIVE_MAXIMUM_WITHOUT_MAXIMUM))
 		}
 
 		if schema.Minimum == nil && schema.ExclusiveMinimum != nil && *schema.ExclusiveMinimum {
-			problems = append(problems, Violation{Path: fmt.Sprintf(minimum_format_pattern, ctx.prefix), Message: lint_message.REQUIRED_FIELD})
+			problems = append(problems, newViolation(fmt.Sprintf(minimum_format_pattern, ctx.prefix), lint_rule.EXCLUSIVE_MINIMUM_WITHOUT_MINIMUM))
 		}
 	} else {
 		problems = append(problems, doLintNumberSchemaBoundary(ctx, schema, typeOf)...)
@@ -163,19 +165,19 @@ func doLintNumberSchemaBoundary(ctx *LintContext, schema *project.Schema, typeOf
 	if typeOf != project.Number {
 
 		if schema.Maximum != nil {
-			problems = append(problems, Violation{Path: fmt.Sprintf("%s/maximum", ctx.prefix), Message: lint_message.FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_NUMBER})
+			problems = append(problems, newViolation(fmt.Sprintf("%s/maximum", ctx.prefix), lint_rule.FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_NUMBER))
 		}
 
 		if schema.Minimum != nil {
-			problems = append(problems, Violation{Path: fmt.Sprintf(minimum_format_pattern, ctx.prefix), Message: lint_message.FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_NUMBER})
+			problems = append(problems, newViolation(fmt.Sprintf(minimum_format_pattern, ctx.prefix), lint_rule.FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_NUMBER))
 		}
 
 		if schema.ExclusiveMaximum != nil {
-			problems = append(problems, Violation{Path: fmt.Sprintf("%s/exclusive-maximum", ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+			problems = append(problems, newViolation(fmt.Sprintf("%s/exclusive-maximum", ctx.prefix), lint_rule.EXCLUSIVE_BOUNDARY_IN_NON_NUMBER))
 		}
 
 		if schema.ExclusiveMinimum != nil {
-			problems = append(problems, Violation{Path: fmt.Sprintf("%s/exclusive-minimum", ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+			problems = append(problems, newViolation(fmt.Sprintf("%s/exclusive-minimum", ctx.prefix), lint_rule.EXCLUSIVE_BOUNDARY_IN_NON_NUMBER))
 		}
 
 	}
@@ -188,25 +190,25 @@ func doLintArraySchema(ctx *LintContext, schema *project.Schema, typeOf project.
 	problems := doLintArraySchemaLength(ctx, schema, typeOf)
 
 	if typeOf != project.Array && schema.UniqueItems != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/unique-items", ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/unique-items", ctx.prefix), lint_rule.ARRAY_FIELD_IN_NON_ARRAY))
 	}
 
 	if typeOf == project.Array && schema.Items != nil && schema.Items.TypeOf != nil && *schema.Items.TypeOf == project.Array {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/items/type", ctx.prefix), Message: lint_message.ARRAY_FIELD_TYPE_NOT_ALLOWED})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/items/type", ctx.prefix), lint_rule.ARRAY_FIELD_TYPE_NOT_ALLOWED))
 		return problems
 	}
 
 	if typeOf == project.Array && schema.Items == nil && schema.RefersTo == nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/items", ctx.prefix), Message: lint_message.REQUIRED_FIELD})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/items", ctx.prefix), lint_rule.REQUIRED_ARRAY_ITEMS))
 	}
 
 	if schema.Items != nil {
-		copyOf := &LintContext{prefix: ctx.prefix + "/items", schemas: ctx.schemas}
+		copyOf := &LintContext{prefix: ctx.prefix + "/items", document: ctx.document, schemas: ctx.schemas}
 		problems = append(problems, doLintSchema(copyOf, schema.Items)...)
 	}
 
 	if typeOf == project.Array && schema.Format != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf(schema_format_pattern, ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+		problems = append(problems, newViolation(fmt.Sprintf(schema_format_pattern, ctx.prefix), lint_rule.FORMAT_IN_ARRAY))
 	}
 
 	return problems
@@ -217,19 +219,19 @@ func doLintArraySchemaLength(ctx *LintContext, schema *project.Schema, typeOf pr
 	problems := make([]Violation, 0)
 
 	if typeOf != project.Array && schema.MaxItems != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/max-items", ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/max-items", ctx.prefix), lint_rule.ARRAY_FIELD_IN_NON_ARRAY))
 	}
 
 	if typeOf != project.Array && schema.MinItems != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf(min_items_format_pattern, ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+		problems = append(problems, newViolation(fmt.Sprintf(min_items_format_pattern, ctx.prefix), lint_rule.ARRAY_FIELD_IN_NON_ARRAY))
 	}
 
 	if typeOf == project.Array && schema.MinItems != nil && *schema.MinItems < 0 {
-		problems = append(problems, Violation{Path: fmt.Sprintf(min_items_format_pattern, ctx.prefix), Message: lint_message.FIELD_MIN_ITEMS_GT_ZERO})
+		problems = append(problems, newViolation(fmt.Sprintf(min_items_format_pattern, ctx.prefix), lint_rule.FIELD_MIN_ITEMS_GT_ZERO))
 	}
 
 	if typeOf == project.Array && schema.MaxItems != nil && *schema.MaxItems < 0 {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/max-items", ctx.prefix), Message: lint_message.FIELD_MAX_ITEMS_GT_ZERO})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/max-items", ctx.prefix), lint_rule.FIELD_MAX_ITEMS_GT_ZERO))
 	}
 
 	if typeOf == project.Array && schema.MinItems != nil && schema.MaxItems != nil {
@@ -237,7 +239,7 @@ func doLintArraySchemaLength(ctx *LintContext, schema *project.Schema, typeOf pr
 		minimum := *schema.MinItems
 
 		if minimum > maximum {
-			problems = append(problems, Violation{Path: fmt.Sprintf(min_items_format_pattern, ctx.prefix), Message: lint_message.FIELD_MIN_ITEMS_MUST_NOT_BE_GT_MAX_ITEMS})
+			problems = append(problems, newViolation(fmt.Sprintf(min_items_format_pattern, ctx.prefix), lint_rule.FIELD_MIN_ITEMS_MUST_NOT_BE_GT_MAX_ITEMS))
 		}
 
 	}
@@ -252,10 +254,10 @@ func doLintParameter(ctx *LintContext, parameter *project.Parameter) ([]Violatio
 	problems = append(problems, doLintParameterFields(ctx, parameter)...)
 
 	if parameter.Schema != nil && parameter.Schema.RefersTo != nil {
-		schema = ctx.schemas[*parameter.Schema.RefersTo]
+		schema = getSchema(ctx, *parameter.Schema.RefersTo)
 
 		if schema == nil {
-			problems = append(problems, Violation{Path: fmt.Sprintf("%s/schema/refers-to", ctx.prefix), Message: lint_message.UNRESOLVABLE_FIELD})
+			problems = append(problems, newViolation(fmt.Sprintf("%s/schema/refers-to", ctx.prefix), lint_rule.UNRESOLVABLE_REFERENCE))
 		}
 
 		return problems, nil
@@ -280,31 +282,31 @@ func doLintParameterFields(ctx *LintContext, parameter *project.Parameter) []Vio
 	}
 
 	if parameter.Description == nil || utils.IsBlank(*parameter.Description) {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/description", ctx.prefix), Message: lint_message.REQUIRED_FIELD})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/description", ctx.prefix), lint_rule.REQUIRED_PARAMETER_DESCRIPTION))
 	}
 
 	if parameter.Name == nil || utils.IsBlank(*parameter.Name) {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/name", ctx.prefix), Message: lint_message.REQUIRED_FIELD})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/name", ctx.prefix), lint_rule.REQUIRED_PARAMETER_NAME))
 	}
 
 	if parameter.Index != nil && *parameter.Index < 0 {
-		problems = append(problems, Violation{Path: fmt.Sprintf(index_format_pattern, ctx.prefix), Message: lint_message.FIELD_INDEX_GT_ZERO})
+		problems = append(problems, newViolation(fmt.Sprintf(index_format_pattern, ctx.prefix), lint_rule.FIELD_INDEX_GT_ZERO))
 	}
 
 	if (parameter.In == nil || *parameter.In == project.Flags) && parameter.Index != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf(index_format_pattern, ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+		problems = append(problems, newViolation(fmt.Sprintf(index_format_pattern, ctx.prefix), lint_rule.INDEX_IN_FLAGS))
 	}
 
 	if parameter.In != nil && *parameter.In == project.Arguments && parameter.Index == nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf(index_format_pattern, ctx.prefix), Message: lint_message.FIELD_WHEN_IN_ARGUMENTS})
+		problems = append(problems, newViolation(fmt.Sprintf(index_format_pattern, ctx.prefix), lint_rule.FIELD_WHEN_IN_ARGUMENTS))
 	}
 
 	if parameter.In != nil && *parameter.In == project.Arguments && parameter.ShortForm != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/short-form", ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/short-form", ctx.prefix), lint_rule.SHORT_FORM_IN_ARGUMENTS))
 	}
 
 	if parameter.RefersTo != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf(refers_to_format_pattern, ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+		problems = append(problems, newViolation(fmt.Sprintf(refers_to_format_pattern, ctx.prefix), lint_rule.REFERS_TO_WITH_DEFINITION))
 	}
 
 	return problems
@@ -315,11 +317,11 @@ func doLintParameterSchema(ctx *LintContext, parameter *project.Parameter) ([]Vi
 	problems := make([]Violation, 0)
 
 	if parameter.Schema == nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/schema", ctx.prefix), Message: lint_message.REQUIRED_FIELD})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/schema", ctx.prefix), lint_rule.REQUIRED_PARAMETER_SCHEMA))
 	}
 
 	if parameter.Schema != nil {
-		context := LintContext{prefix: fmt.Sprintf("%s/schema", ctx.prefix), schemas: ctx.schemas}
+		context := LintContext{prefix: fmt.Sprintf("%s/schema", ctx.prefix), document: ctx.document, schemas: ctx.schemas}
 		problems = append(problems, doLintSchema(&context, parameter.Schema)...)
 	}
 
@@ -330,15 +332,15 @@ func doLintExit(ctx *LintContext, exit *project.Exit) ([]Violation, error) {
 	problems := make([]Violation, 0)
 
 	if exit.Message == nil || utils.IsBlank(*exit.Message) {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/message", ctx.prefix), Message: lint_message.REQUIRED_FIELD})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/message", ctx.prefix), lint_rule.REQUIRED_EXIT_MESSAGE))
 	}
 
 	if exit.Code == nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/code", ctx.prefix), Message: lint_message.REQUIRED_FIELD})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/code", ctx.prefix), lint_rule.REQUIRED_EXIT_CODE))
 	}
 
 	if exit.RefersTo != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf(refers_to_format_pattern, ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+		problems = append(problems, newViolation(fmt.Sprintf(refers_to_format_pattern, ctx.prefix), lint_rule.REFERS_TO_WITH_DEFINITION))
 	}
 
 	return problems, nil
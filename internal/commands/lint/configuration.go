@@ -0,0 +1,148 @@
+package lint
+
+import (
+	"fmt"
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_rule"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	ConfigurationFilename = ".antlint.yaml"
+	off                   = "off"
+)
+
+type Configuration struct {
+	Rules  map[string]string `yaml:"rules"`
+	Ignore []string          `yaml:"ignore"`
+}
+
+// GetConfiguration reads the configuration from filename or, when filename is empty, from the
+// .antlint.yaml next to the specification, which is optional
+func GetConfiguration(specification string, filename string) (*Configuration, error) {
+	isOptional := filename == ""
+
+	if isOptional {
+		filename = filepath.Join(filepath.Dir(specification), ConfigurationFilename)
+	}
+
+	if _, err := os.Stat(filename); err != nil && isOptional {
+		return &Configuration{}, nil
+	}
+
+	file, err := internal.GetFile(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	configuration := &Configuration{}
+
+	if err = yaml.Unmarshal(file.GetContent(), configuration); err != nil {
+		return nil, internal.GetProblemFactory().GetInvalidConfiguration(filename, err)
+	}
+
+	for key, value := range configuration.Rules {
+		rule := lint_rule.GetRule(key)
+
+		if rule == nil {
+			return nil, internal.GetProblemFactory().GetInvalidConfiguration(filename, fmt.Sprintf("unknown rule %s", key))
+		}
+
+		if rule.Id == lint_rule.SCHEMA_VIOLATION.Id && value != string(lint_rule.Error) {
+			return nil, internal.GetProblemFactory().GetInvalidConfiguration(filename, fmt.Sprintf("rule %s can't be turned off or downgraded", key))
+		}
+
+		if !(value == off || value == string(lint_rule.Error) || value == string(lint_rule.Warning) || value == string(lint_rule.Info)) {
+			return nil, internal.GetProblemFactory().GetInvalidConfiguration(filename, fmt.Sprintf("unknown severity %s of rule %s", value, key))
+		}
+	}
+
+	return configuration, nil
+}
+
+func (instance *Configuration) apply(problems []Violation) []Violation {
+
+	if instance == nil {
+		return problems
+	}
+
+	array := make([]Violation, 0)
+
+	for _, each := range problems {
+
+		if isMandatory(each) {
+			array = append(array, each)
+			continue
+		}
+
+		value := instance.getSeverity(each.RuleId)
+
+		if value == off || instance.isIgnored(each.Path) {
+			continue
+		}
+
+		if value != "" {
+			each.Severity = lint_rule.Severity(value)
+		}
+
+		array = append(array, each)
+	}
+
+	return array
+}
+
+// isMandatory determines whether the violation is always reported, which is the case of a schema violation since every
+// other rule, as well as the commands which require a valid document, assume a document that complies with the schema
+func isMandatory(violation Violation) bool {
+	return violation.RuleId == lint_rule.SCHEMA_VIOLATION.Id
+}
+
+func (instance *Configuration) getSeverity(id string) string {
+	for key, value := range instance.Rules {
+		if rule := lint_rule.GetRule(key); rule != nil && rule.Id == id {
+			return value
+		}
+	}
+	return ""
+}
+
+func (instance *Configuration) isIgnored(pointer string) bool {
+	for _, pattern := range instance.Ignore {
+		if isMatch(strings.Split(strings.TrimPrefix(pattern, "/"), "/"), strings.Split(strings.TrimPrefix(pointer, "/"), "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMatch matches each segment of the JSON pointer against the glob, where ** matches zero or more segments
+func isMatch(pattern []string, segments []string) bool {
+
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+
+	if pattern[0] == "**" {
+		for index := 0; index <= len(segments); index++ {
+			if isMatch(pattern[1:], segments[index:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(segments) == 0 {
+		return false
+	}
+
+	if matches, err := path.Match(pattern[0], segments[0]); err != nil || !matches {
+		return false
+	}
+
+	return isMatch(pattern[1:], segments[1:])
+}
@@ -0,0 +1,111 @@
+package lint
+
+import (
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_rule"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetConfiguration_where_configuration_is_next_to_specification(t *testing.T) {
+	configuration, err := GetConfiguration("testdata/antlint/index.json", "")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "off", configuration.Rules["ANT0011"])
+	assert.Equal(t, []string{"/commands/**/parameters"}, configuration.Ignore)
+}
+
+func TestGetConfiguration_where_configuration_is_missing(t *testing.T) {
+	configuration, err := GetConfiguration("testdata/index-003.json", "")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &Configuration{}, configuration)
+}
+
+func TestGetConfiguration_where_specified_configuration_is_missing(t *testing.T) {
+	_, err := GetConfiguration("testdata/index-003.json", "testdata/.antlint.yaml")
+
+	assert.NotNil(t, err)
+}
+
+func TestGetConfiguration_where_rule_is_unknown(t *testing.T) {
+	_, err := GetConfiguration("testdata/index-003.json", "testdata/antlint-001.yaml")
+
+	assert.NotNil(t, err)
+}
+
+func TestGetConfiguration_where_severity_is_unknown(t *testing.T) {
+	_, err := GetConfiguration("testdata/index-003.json", "testdata/antlint-002.yaml")
+
+	assert.NotNil(t, err)
+}
+
+func TestGetConfiguration_where_schema_violation_is_off(t *testing.T) {
+	_, err := GetConfiguration("testdata/index-003.json", "testdata/antlint-003.yaml")
+
+	assert.NotNil(t, err)
+}
+
+func TestLintWith_where_rule_is_off(t *testing.T) {
+	array := doLintWith(t, "index-015.json", &Configuration{Rules: map[string]string{"example-not-in-enum": "off"}})
+
+	assert.Equal(t, 0, len(array))
+}
+
+func TestLintWith_where_other_check_with_same_message_is_off(t *testing.T) {
+	array := doLintWith(t, "index-019.json", &Configuration{Rules: map[string]string{"index-in-flags": "off"}})
+
+	assert.Equal(t, 1, len(array))
+	assert.Equal(t, lint_rule.PATTERN_IN_NON_STRING.Id, array[0].RuleId)
+}
+
+func TestLintWith_where_severity_is_overridden(t *testing.T) {
+	array := doLintWith(t, "index-018.json", &Configuration{Rules: map[string]string{"ANT0012": "warning"}})
+
+	assert.Equal(t, 1, len(array))
+	assert.Equal(t, lint_rule.Warning, array[0].Severity)
+}
+
+func TestLintWith_where_path_is_ignored(t *testing.T) {
+	array := doLintWith(t, "index-009.json", &Configuration{Ignore: []string{"/commands/**/parameters"}})
+
+	assert.Equal(t, 1, len(array))
+	assert.Equal(t, "/parameters/0/index", array[0].Path)
+}
+
+func TestLintWith_where_schema_violation_is_ignored(t *testing.T) {
+	array := doLintWith(t, "index-078.yaml", &Configuration{Ignore: []string{"/commands/**"}})
+
+	assert.Equal(t, 1, len(array))
+	assert.Equal(t, lint_rule.SCHEMA_VIOLATION.Id, array[0].RuleId)
+}
+
+func TestIsMatch(t *testing.T) {
+	assert.True(t, isMatch([]string{"commands", "*", "parameters"}, []string{"commands", "0", "parameters"}))
+	assert.False(t, isMatch([]string{"commands", "*", "parameters"}, []string{"commands", "1", "commands", "0", "parameters"}))
+	assert.True(t, isMatch([]string{"commands", "**"}, []string{"commands", "1", "commands", "0", "parameters"}))
+	assert.True(t, isMatch([]string{"**", "schema", "min-*"}, []string{"parameters", "0", "schema", "min-length"}))
+	assert.False(t, isMatch([]string{"parameters"}, []string{"parameters", "0"}))
+}
+
+func doLintWith(t *testing.T, filename string, configuration *Configuration) []Violation {
+	ctx, err := internal.GetContext("testdata/" + filename)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	array, err := LintWith(ctx, configuration)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return array
+}
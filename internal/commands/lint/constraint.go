@@ -0,0 +1,110 @@
+package lint
+
+import (
+	"fmt"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_rule"
+	"github.com/raitonbl/ant/pkg/project"
+)
+
+// doLintCommandConstraints verifies that each constraint references parameters of the command and that it can be satisfied
+func doLintCommandConstraints(commandContext *CommandLintingContext, document *project.Specification, instance *project.Command) []Violation {
+	problems := make([]Violation, 0)
+
+	if instance.Constraints == nil {
+		return problems
+	}
+
+	command := &project.ResolvedCommand{Command: instance}
+
+	for _, each := range instance.Parameters {
+		if param := document.ResolveParameter(each); param != nil {
+			command.Parameters = append(command.Parameters, param)
+		}
+	}
+
+	prefix := fmt.Sprintf("%s/constraints", commandContext.path)
+	constraints := instance.Constraints
+
+	problems = append(problems, doLintConstraintGroups(fmt.Sprintf("%s/exclusive", prefix), command, constraints.Exclusive)...)
+	problems = append(problems, doLintConstraintGroups(fmt.Sprintf("%s/required-together", prefix), command, constraints.RequiredTogether)...)
+	problems = append(problems, doLintConstraintGroups(fmt.Sprintf("%s/at-least-one-of", prefix), command, constraints.AtLeastOneOf)...)
+
+	for index, group := range constraints.Exclusive {
+		if isUnsatisfiable(command, group, constraints.RequiredTogether) {
+			problems = append(problems, newViolation(fmt.Sprintf("%s/exclusive/%d", prefix, index), lint_rule.UNSATISFIABLE_CONSTRAINT))
+		}
+	}
+
+	return problems
+}
+
+func doLintConstraintGroups(prefix string, command *project.ResolvedCommand, groups [][]string) []Violation {
+	problems := make([]Violation, 0)
+
+	for index, group := range groups {
+		cache := make(map[*project.Parameter]bool)
+
+		for position, reference := range group {
+			path := fmt.Sprintf("%s/%d/%d", prefix, index, position)
+			param := command.GetParameter(reference)
+
+			if param == nil {
+				problems = append(problems, newViolation(path, lint_rule.UNRESOLVABLE_CONSTRAINT_PARAMETER))
+				continue
+			}
+
+			if cache[param.Parameter] {
+				problems = append(problems, newViolation(path, lint_rule.DUPLICATED_CONSTRAINT_PARAMETER))
+			}
+
+			cache[param.Parameter] = true
+		}
+	}
+
+	return problems
+}
+
+// isUnsatisfiable determines whether the exclusive group cannot be satisfied, which happens when two of its parameters are
+// required or must be given together
+func isUnsatisfiable(command *project.ResolvedCommand, exclusive []string, requiredTogether [][]string) bool {
+	group := getConstraintParameters(command, exclusive)
+	required := 0
+
+	for param := range group {
+		if param.IsRequired() {
+			required++
+		}
+	}
+
+	if required > 1 {
+		return true
+	}
+
+	for _, each := range requiredTogether {
+		count := 0
+
+		for param := range getConstraintParameters(command, each) {
+			if group[param] {
+				count++
+			}
+		}
+
+		if count > 1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getConstraintParameters(command *project.ResolvedCommand, group []string) map[*project.Parameter]bool {
+	cache := make(map[*project.Parameter]bool)
+
+	for _, reference := range group {
+		if param := command.GetParameter(reference); param != nil {
+			cache[param.Parameter] = true
+		}
+	}
+
+	return cache
+}
@@ -2,17 +2,16 @@ package lint
 
 import (
 	"fmt"
-	"github.com/raitonbl/ant/internal/commands/lint/lint_message"
-	"github.com/raitonbl/ant/internal/project"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_rule"
 	"github.com/raitonbl/ant/internal/utils"
+	"github.com/raitonbl/ant/pkg/project"
 )
 
-func doLintExitSection(document *project.Specification) (map[string]*project.Exit, []Violation, error) {
+func doLintExitSection(document *project.Specification) ([]Violation, error) {
 	problems := make([]Violation, 0)
-	cache := make(map[string]*project.Exit)
 
 	if document.Exit == nil {
-		return cache, problems, nil
+		return problems, nil
 	}
 
 	for index, exit := range document.Exit {
@@ -20,21 +19,19 @@ func doLintExitSection(document *project.Specification) (map[string]*project.Exi
 		ctx := &LintContext{prefix: fmt.Sprintf("/exit/%d", index), document: document}
 
 		if exit.Id == nil || utils.IsBlank(*exit.Id) {
-			problems = append(problems, Violation{Path: fmt.Sprintf("%s/id", ctx.prefix), Message: lint_message.REQUIRED_FIELD})
+			problems = append(problems, newViolation(fmt.Sprintf("%s/id", ctx.prefix), lint_rule.REQUIRED_ID))
 		}
 
 		v, prob := doLintExit(ctx, &exit)
 
 		if prob != nil {
-			return nil, nil, prob
+			return nil, prob
 		}
 
 		problems = append(problems, v...)
-
-		cache[*exit.Id] = &exit
 	}
 
-	return cache, problems, nil
+	return problems, nil
 }
 
 func doLintCommandExitSection(commandContext *CommandLintingContext, document *project.Specification, instance *project.Command) ([]Violation, error) {
@@ -60,19 +57,18 @@ func doLintCommandExit(commandContext *CommandLintingContext, document *project.
 
 	prefix := commandContext.path
 	problems := make([]Violation, 0)
-	exitCache := commandContext.exitCache
 
 	exit := &each
 	isReference := isExitReference(&each)
 	ctx := &LintContext{prefix: fmt.Sprintf("%s/exit/%d", prefix, index), document: document}
 
 	if each.RefersTo != nil && !isReference {
-		problems = append(problems, Violation{Path: fmt.Sprintf(refers_to_format_pattern, ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+		problems = append(problems, newViolation(fmt.Sprintf(refers_to_format_pattern, ctx.prefix), lint_rule.REFERS_TO_WITH_DEFINITION))
 	} else if each.RefersTo != nil && isReference {
-		exit = exitCache[*each.RefersTo]
+		exit = commandContext.document.GetExit(*each.RefersTo)
 
 		if exit == nil {
-			problems = append(problems, Violation{Path: fmt.Sprintf(refers_to_format_pattern, ctx.prefix), Message: lint_message.UNRESOLVABLE_FIELD})
+			problems = append(problems, newViolation(fmt.Sprintf(refers_to_format_pattern, ctx.prefix), lint_rule.UNRESOLVABLE_REFERENCE))
 		}
 
 		return problems, nil
@@ -109,6 +105,10 @@ func isExitReference(each *project.Exit) bool {
 		return false
 	}
 
+	if each.Deprecated != nil {
+		return false
+	}
+
 	return true
 }
 
@@ -5,26 +5,41 @@ import (
 	"encoding/json"
 	"github.com/qri-io/jsonschema"
 	"github.com/raitonbl/ant/internal"
-	"github.com/raitonbl/ant/internal/project"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_rule"
+	"github.com/raitonbl/ant/pkg/project"
 	"github.com/raitonbl/ant/pkg/resources"
 	"sigs.k8s.io/yaml"
 	"strings"
 )
 
 type Violation struct {
-	Path    string
-	Message string
+	Path     string             `json:"path"`
+	Message  string             `json:"message"`
+	RuleId   string             `json:"rule-id"`
+	Severity lint_rule.Severity `json:"severity"`
+	File     string             `json:"file,omitempty"`
+	Line     int                `json:"line,omitempty"`
+	Column   int                `json:"column,omitempty"`
+}
+
+func newViolation(path string, rule lint_rule.Rule) Violation {
+	return Violation{Path: path, Message: rule.Message, RuleId: rule.Id, Severity: rule.Severity}
 }
 
 type CommandLintingContext struct {
-	path           string
-	commandCache   map[string]*project.Command
-	exitCache      map[string]*project.Exit
-	parameterCache map[string]*project.Parameter
-	schemaCache    map[string]*project.Schema
+	path string
+	// names is the path through which the command is invoked (e.g. build stack)
+	names        []string
+	document     *project.Specification
+	commandCache map[string]*project.Command
+	schemaCache  map[string]*project.Schema
 }
 
 func Lint(context internal.ProjectContext) ([]Violation, error) {
+	return LintWith(context, nil)
+}
+
+func LintWith(context internal.ProjectContext, configuration *Configuration) ([]Violation, error) {
 
 	if context == nil {
 		return nil, internal.GetProblemFactory().GetUnexpectedContext()
@@ -34,43 +49,63 @@ func Lint(context internal.ProjectContext) ([]Violation, error) {
 		return nil, internal.GetProblemFactory().GetConfigurationFileNotFound()
 	}
 
-	problems, err := doLint(context)
+	problems, err := doLint(context, configuration)
 
 	if err != nil {
 		return nil, err
 	}
 
+	for index := range problems {
+		if problems[index].File == "" {
+			setPosition(context.GetProjectFile(), &problems[index])
+		}
+	}
+
 	return problems, nil
 }
 
-func doLint(context internal.ProjectContext) ([]Violation, error) {
+func IsFailure(problems []Violation, strict bool) bool {
+	for _, each := range problems {
+		if each.Severity == lint_rule.Error || (strict && each.Severity == lint_rule.Warning) {
+			return true
+		}
+	}
+	return false
+}
+
+func setPosition(file *internal.File, violation *Violation) {
+	violation.File = file.GetName()
+	position := file.GetPosition(violation.Path)
+
+	if position != nil {
+		violation.Line = position.Line
+		violation.Column = position.Column
+	}
+}
+
+func doLint(context internal.ProjectContext, configuration *Configuration) ([]Violation, error) {
 
-	binary := make([]byte, 0)
 	problems := make([]Violation, 0)
+	binary, err := getBinary(context.GetProjectFile())
 
-	if strings.HasSuffix(context.GetProjectFile().GetName(), ".json") {
-		binary = context.GetProjectFile().GetContent()
-	} else if strings.HasSuffix(context.GetProjectFile().GetName(), ".yaml") || strings.HasSuffix(context.GetProjectFile().GetName(), ".yml") {
-		binary = context.GetProjectFile().GetContent()
-		content, err := yaml.YAMLToJSON(binary)
+	if err != nil {
+		return nil, err
+	}
 
-		if err != nil {
-			return nil, err
-		}
+	array, err := doLintFile(binary, false)
 
-		binary = content
-	} else {
-		return nil, internal.GetProblemFactory().GetProblem("the specified doesn't meet the expected extension[json|yaml|yml]")
+	if err != nil {
+		return nil, err
 	}
 
-	array, err := doLintFile(binary)
+	suppressions, err := getSuppressions(binary)
 
 	if err != nil {
 		return nil, err
 	}
 
 	if len(array) > 0 {
-		return array, nil
+		return configuration.apply(suppress(suppressions, array)), nil
 	}
 
 	array, err = doLintObject(context)
@@ -79,10 +114,33 @@ func doLint(context internal.ProjectContext) ([]Violation, error) {
 		return nil, err
 	}
 
-	return append(problems, array...), nil
+	problems = append(problems, configuration.apply(suppress(suppressions, array))...)
+
+	array, err = doLintReferences(context)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(problems, configuration.apply(array)...), nil
 }
 
-func doLintFile(binary []byte) ([]Violation, error) {
+func getBinary(file *internal.File) ([]byte, error) {
+
+	if strings.HasSuffix(file.GetName(), ".json") {
+		return file.GetContent(), nil
+	}
+
+	if strings.HasSuffix(file.GetName(), ".yaml") || strings.HasSuffix(file.GetName(), ".yml") {
+		return yaml.YAMLToJSON(file.GetContent())
+	}
+
+	return nil, internal.GetProblemFactory().GetProblem("the specified doesn't meet the expected extension[json|yaml|yml]")
+}
+
+// doLintFile validates the content against the specification schema, which doesn't require
+// the document fields when the content belongs to a referenced file
+func doLintFile(binary []byte, isReference bool) ([]Violation, error) {
 	goContext := context.Background()
 
 	schema, err := resources.GetResource("schema.json")
@@ -91,6 +149,14 @@ func doLintFile(binary []byte) ([]Violation, error) {
 		return nil, internal.GetProblemFactory().GetProblem(err)
 	}
 
+	if isReference {
+		schema, err = getReferenceSchema(schema)
+
+		if err != nil {
+			return nil, internal.GetProblemFactory().GetProblem(err)
+		}
+	}
+
 	rs := &jsonschema.Schema{}
 
 	if err := json.Unmarshal(schema, rs); err != nil {
@@ -106,7 +172,8 @@ func doLintFile(binary []byte) ([]Violation, error) {
 	problems := make([]Violation, len(errs))
 
 	for index, each := range errs {
-		problems[index] = Violation{Path: each.PropertyPath, Message: each.Message}
+		problems[index] = newViolation(each.PropertyPath, lint_rule.SCHEMA_VIOLATION)
+		problems[index].Message = each.Message
 	}
 
 	return problems, nil
@@ -134,11 +201,7 @@ func doLintObject(ctx internal.ProjectContext) ([]Violation, error) {
 
 	problems = append(problems, array...)
 
-	if document.Parameters == nil {
-		return problems, nil
-	}
-
-	parameterCache, array, err := doLintParameterSection(document, schemaCache)
+	array, err = doLintParameterSection(document, schemaCache)
 
 	if err != nil {
 		return nil, err
@@ -146,7 +209,7 @@ func doLintObject(ctx internal.ProjectContext) ([]Violation, error) {
 
 	problems = append(problems, array...)
 
-	exitCache, array, err := doLintExitSection(document)
+	array, err = doLintExitSection(document)
 
 	if err != nil {
 		return nil, err
@@ -154,7 +217,7 @@ func doLintObject(ctx internal.ProjectContext) ([]Violation, error) {
 
 	problems = append(problems, array...)
 
-	array, err = doLintCommandSection(document, parameterCache, exitCache, schemaCache)
+	array, err = doLintCommandSection(document, schemaCache)
 
 	if err != nil {
 		return nil, err
@@ -1,6 +1,7 @@
 package lint_message
 
 const (
+	SCHEMA_VIOLATION                            = "document doesn't comply with the ant CLI document schema"
 	UNRESOLVABLE_FIELD                          = "field is unresolvable"
 	BLANK_FIELD                                 = "field mustn't be blank"
 	DUPLICATED_FIELD_VALUE                      = "value must be unique"
@@ -14,7 +15,7 @@ const (
 	FIELD_MAX_LENGTH_GT_ZERO                    = "max-length cannot be lesser than zero (0)"
 	FIELD_MIN_LENGTH_GT_ZERO                    = "min-length cannot be lesser than zero (0)"
 	FIELD_MIN_LENGTH_MUST_NOT_BE_GT_MAX_LENGTH  = "min-length mustn't be greater than max-length"
-	FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_NUMBER = "specified format can only be applied to type=string"
+	FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_NUMBER = "specified format can only be applied to type=number"
 	FIELD_MIN_MUST_NOT_BE_GT_MAX                = "minimum mustn't be greater than maximum"
 	FIELD_MIN_ITEMS_MUST_NOT_BE_GT_MAX_ITEMS    = "min-items mustn't be greater than max-items"
 	FIELD_MAX_ITEMS_GT_ZERO                     = "max-items cannot be lesser than zero (0)"
@@ -23,4 +24,7 @@ const (
 	NOT_AVAILABLE_IN_USE                        = "not available since the value has been defined"
 	ARGS_INDEX_NOT_ORDERED                      = "arguments index must start in zero(0) and be sequential"
 	ARGS_INDEX_NOT_UNIQUE                       = "arguments index must be unique"
+	UNSATISFIABLE_CONSTRAINT                    = "constraint cannot be satisfied"
+	VARIADIC_ARGUMENT_NOT_LAST                  = "only the argument with the last index can be variadic"
+	REPLACED_BY_ITSELF                          = "cannot be replaced by itself"
 )
@@ -0,0 +1,150 @@
+package lint_rule
+
+import "github.com/raitonbl/ant/internal/commands/lint/lint_message"
+
+type Severity string
+
+const (
+	Error   Severity = "error"
+	Warning Severity = "warning"
+	Info    Severity = "info"
+)
+
+type Rule struct {
+	Id       string
+	Name     string
+	Severity Severity
+	Message  string
+}
+
+var (
+	SCHEMA_VIOLATION                            = Rule{Id: "ANT0001", Name: "schema-violation", Severity: Error, Message: lint_message.SCHEMA_VIOLATION}
+	UNRESOLVABLE_REFERENCE                      = Rule{Id: "ANT0002", Name: "unresolvable-reference", Severity: Error, Message: lint_message.UNRESOLVABLE_FIELD}
+	BLANK_COMMAND_NAME                          = Rule{Id: "ANT0003", Name: "blank-command-name", Severity: Error, Message: lint_message.BLANK_FIELD}
+	DUPLICATED_ID                               = Rule{Id: "ANT0004", Name: "duplicated-id", Severity: Error, Message: lint_message.DUPLICATED_FIELD_VALUE}
+	REQUIRED_ID                                 = Rule{Id: "ANT0005", Name: "required-id", Severity: Error, Message: lint_message.REQUIRED_FIELD}
+	REFERS_TO_WITH_DEFINITION                   = Rule{Id: "ANT0006", Name: "refers-to-with-definition", Severity: Error, Message: lint_message.FIELD_NOT_ALLOWED}
+	FIELD_INDEX_GT_ZERO                         = Rule{Id: "ANT0007", Name: "negative-index", Severity: Error, Message: lint_message.FIELD_INDEX_GT_ZERO}
+	FIELD_WHEN_IN_ARGUMENTS                     = Rule{Id: "ANT0008", Name: "index-required-in-arguments", Severity: Error, Message: lint_message.FIELD_WHEN_IN_ARGUMENTS}
+	FIELD_FORMAT_NOT_ALLOWED_IN_TYPE_STRING     = Rule{Id: "ANT0009", Name: "format-not-allowed-in-string", Severity: Error, Message: lint_message.FIELD_FORMAT_NOT_ALLOWED_IN_TYPE_STRING}
+	FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_STRING = Rule{Id: "ANT0010", Name: "string-field-in-non-string", Severity: Error, Message: lint_message.FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_STRING}
+	FIELD_EXAMPLE_MUST_BE_PART_OF_ENUM          = Rule{Id: "ANT0011", Name: "example-not-in-enum", Severity: Warning, Message: lint_message.FIELD_EXAMPLE_MUST_BE_PART_OF_ENUM}
+	FIELD_MIN_LENGTH_MUST_NOT_BE_GT_MAX_LENGTH  = Rule{Id: "ANT0012", Name: "min-length-gt-max-length", Severity: Error, Message: lint_message.FIELD_MIN_LENGTH_MUST_NOT_BE_GT_MAX_LENGTH}
+	FIELD_MAX_LENGTH_GT_ZERO                    = Rule{Id: "ANT0013", Name: "negative-max-length", Severity: Error, Message: lint_message.FIELD_MAX_LENGTH_GT_ZERO}
+	FIELD_MIN_LENGTH_GT_ZERO                    = Rule{Id: "ANT0014", Name: "negative-min-length", Severity: Error, Message: lint_message.FIELD_MIN_LENGTH_GT_ZERO}
+	FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_NUMBER = Rule{Id: "ANT0015", Name: "number-field-in-non-number", Severity: Error, Message: lint_message.FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_NUMBER}
+	FIELD_MIN_MUST_NOT_BE_GT_MAX                = Rule{Id: "ANT0016", Name: "minimum-gt-maximum", Severity: Error, Message: lint_message.FIELD_MIN_MUST_NOT_BE_GT_MAX}
+	FIELD_MIN_ITEMS_MUST_NOT_BE_GT_MAX_ITEMS    = Rule{Id: "ANT0017", Name: "min-items-gt-max-items", Severity: Error, Message: lint_message.FIELD_MIN_ITEMS_MUST_NOT_BE_GT_MAX_ITEMS}
+	FIELD_MAX_ITEMS_GT_ZERO                     = Rule{Id: "ANT0018", Name: "negative-max-items", Severity: Error, Message: lint_message.FIELD_MAX_ITEMS_GT_ZERO}
+	FIELD_MIN_ITEMS_GT_ZERO                     = Rule{Id: "ANT0019", Name: "negative-min-items", Severity: Error, Message: lint_message.FIELD_MIN_ITEMS_GT_ZERO}
+	ARRAY_FIELD_TYPE_NOT_ALLOWED                = Rule{Id: "ANT0020", Name: "nested-array", Severity: Error, Message: lint_message.ARRAY_FIELD_TYPE_NOT_ALLOWED}
+	DUPLICATED_FLAG_NAME                        = Rule{Id: "ANT0021", Name: "duplicated-flag-name", Severity: Error, Message: lint_message.NOT_AVAILABLE_IN_USE}
+	ARGS_INDEX_NOT_ORDERED                      = Rule{Id: "ANT0022", Name: "arguments-index-not-sequential", Severity: Error, Message: lint_message.ARGS_INDEX_NOT_ORDERED}
+	ARGS_INDEX_NOT_UNIQUE                       = Rule{Id: "ANT0023", Name: "arguments-index-not-unique", Severity: Error, Message: lint_message.ARGS_INDEX_NOT_UNIQUE}
+	UNSATISFIABLE_CONSTRAINT                    = Rule{Id: "ANT0024", Name: "unsatisfiable-constraint", Severity: Error, Message: lint_message.UNSATISFIABLE_CONSTRAINT}
+	VARIADIC_ARGUMENT_NOT_LAST                  = Rule{Id: "ANT0025", Name: "variadic-argument-not-last", Severity: Error, Message: lint_message.VARIADIC_ARGUMENT_NOT_LAST}
+	REPLACED_BY_ITSELF                          = Rule{Id: "ANT0026", Name: "replaced-by-itself", Severity: Error, Message: lint_message.REPLACED_BY_ITSELF}
+	BLANK_COMMAND_ID                            = Rule{Id: "ANT0027", Name: "blank-command-id", Severity: Error, Message: lint_message.BLANK_FIELD}
+	REQUIRED_COMMAND_NAME                       = Rule{Id: "ANT0028", Name: "required-command-name", Severity: Error, Message: lint_message.REQUIRED_FIELD}
+	REQUIRED_COMMAND_DESCRIPTION                = Rule{Id: "ANT0029", Name: "required-command-description", Severity: Error, Message: lint_message.REQUIRED_FIELD}
+	BLANK_COMMAND_DESCRIPTION                   = Rule{Id: "ANT0030", Name: "blank-command-description", Severity: Error, Message: lint_message.BLANK_FIELD}
+	BLANK_ALIAS                                 = Rule{Id: "ANT0031", Name: "blank-alias", Severity: Error, Message: lint_message.BLANK_FIELD}
+	ALIAS_IN_USE                                = Rule{Id: "ANT0032", Name: "alias-in-use", Severity: Error, Message: lint_message.NOT_AVAILABLE_IN_USE}
+	UNRESOLVABLE_REPLACEMENT                    = Rule{Id: "ANT0033", Name: "unresolvable-replacement", Severity: Error, Message: lint_message.UNRESOLVABLE_FIELD}
+	EXIT_IN_PARENT_COMMAND                      = Rule{Id: "ANT0034", Name: "exit-in-parent-command", Severity: Error, Message: lint_message.FIELD_NOT_ALLOWED}
+	PARAMETERS_IN_PARENT_COMMAND                = Rule{Id: "ANT0035", Name: "parameters-in-parent-command", Severity: Error, Message: lint_message.FIELD_NOT_ALLOWED}
+	REQUIRED_SCHEMA_TYPE                        = Rule{Id: "ANT0036", Name: "required-schema-type", Severity: Error, Message: lint_message.REQUIRED_FIELD}
+	PATTERN_IN_NON_STRING                       = Rule{Id: "ANT0037", Name: "pattern-in-non-string", Severity: Error, Message: lint_message.FIELD_NOT_ALLOWED}
+	EXCLUSIVE_MAXIMUM_WITHOUT_MAXIMUM           = Rule{Id: "ANT0038", Name: "exclusive-maximum-without-maximum", Severity: Error, Message: lint_message.REQUIRED_FIELD}
+	EXCLUSIVE_MINIMUM_WITHOUT_MINIMUM           = Rule{Id: "ANT0039", Name: "exclusive-minimum-without-minimum", Severity: Error, Message: lint_message.REQUIRED_FIELD}
+	EXCLUSIVE_BOUNDARY_IN_NON_NUMBER            = Rule{Id: "ANT0040", Name: "exclusive-boundary-in-non-number", Severity: Error, Message: lint_message.FIELD_NOT_ALLOWED}
+	ARRAY_FIELD_IN_NON_ARRAY                    = Rule{Id: "ANT0041", Name: "array-field-in-non-array", Severity: Error, Message: lint_message.FIELD_NOT_ALLOWED}
+	REQUIRED_ARRAY_ITEMS                        = Rule{Id: "ANT0042", Name: "required-array-items", Severity: Error, Message: lint_message.REQUIRED_FIELD}
+	FORMAT_IN_ARRAY                             = Rule{Id: "ANT0043", Name: "format-in-array", Severity: Error, Message: lint_message.FIELD_NOT_ALLOWED}
+	REQUIRED_PARAMETER_NAME                     = Rule{Id: "ANT0044", Name: "required-parameter-name", Severity: Error, Message: lint_message.REQUIRED_FIELD}
+	REQUIRED_PARAMETER_DESCRIPTION              = Rule{Id: "ANT0045", Name: "required-parameter-description", Severity: Error, Message: lint_message.REQUIRED_FIELD}
+	REQUIRED_PARAMETER_SCHEMA                   = Rule{Id: "ANT0046", Name: "required-parameter-schema", Severity: Error, Message: lint_message.REQUIRED_FIELD}
+	INDEX_IN_FLAGS                              = Rule{Id: "ANT0047", Name: "index-in-flags", Severity: Error, Message: lint_message.FIELD_NOT_ALLOWED}
+	SHORT_FORM_IN_ARGUMENTS                     = Rule{Id: "ANT0048", Name: "short-form-in-arguments", Severity: Error, Message: lint_message.FIELD_NOT_ALLOWED}
+	DUPLICATED_ARGUMENT_NAME                    = Rule{Id: "ANT0049", Name: "duplicated-argument-name", Severity: Error, Message: lint_message.NOT_AVAILABLE_IN_USE}
+	DUPLICATED_SHORT_FORM                       = Rule{Id: "ANT0050", Name: "duplicated-short-form", Severity: Error, Message: lint_message.DUPLICATED_FIELD_VALUE}
+	BLANK_ENV                                   = Rule{Id: "ANT0051", Name: "blank-env", Severity: Error, Message: lint_message.BLANK_FIELD}
+	DUPLICATED_ENV                              = Rule{Id: "ANT0052", Name: "duplicated-env", Severity: Error, Message: lint_message.DUPLICATED_FIELD_VALUE}
+	REQUIRED_EXIT_CODE                          = Rule{Id: "ANT0053", Name: "required-exit-code", Severity: Error, Message: lint_message.REQUIRED_FIELD}
+	REQUIRED_EXIT_MESSAGE                       = Rule{Id: "ANT0054", Name: "required-exit-message", Severity: Error, Message: lint_message.REQUIRED_FIELD}
+	UNRESOLVABLE_CONSTRAINT_PARAMETER           = Rule{Id: "ANT0055", Name: "unresolvable-constraint-parameter", Severity: Error, Message: lint_message.UNRESOLVABLE_FIELD}
+	DUPLICATED_CONSTRAINT_PARAMETER             = Rule{Id: "ANT0056", Name: "duplicated-constraint-parameter", Severity: Error, Message: lint_message.DUPLICATED_FIELD_VALUE}
+	CIRCULAR_SCHEMA_REFERENCE                   = Rule{Id: "ANT0057", Name: "circular-schema-reference", Severity: Error, Message: lint_message.UNRESOLVABLE_FIELD}
+)
+
+func GetRules() []Rule {
+	return []Rule{
+		SCHEMA_VIOLATION,
+		UNRESOLVABLE_REFERENCE,
+		BLANK_COMMAND_NAME,
+		DUPLICATED_ID,
+		REQUIRED_ID,
+		REFERS_TO_WITH_DEFINITION,
+		FIELD_INDEX_GT_ZERO,
+		FIELD_WHEN_IN_ARGUMENTS,
+		FIELD_FORMAT_NOT_ALLOWED_IN_TYPE_STRING,
+		FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_STRING,
+		FIELD_EXAMPLE_MUST_BE_PART_OF_ENUM,
+		FIELD_MIN_LENGTH_MUST_NOT_BE_GT_MAX_LENGTH,
+		FIELD_MAX_LENGTH_GT_ZERO,
+		FIELD_MIN_LENGTH_GT_ZERO,
+		FIELD_FORMAT_IS_ONLY_ALLOWED_IN_TYPE_NUMBER,
+		FIELD_MIN_MUST_NOT_BE_GT_MAX,
+		FIELD_MIN_ITEMS_MUST_NOT_BE_GT_MAX_ITEMS,
+		FIELD_MAX_ITEMS_GT_ZERO,
+		FIELD_MIN_ITEMS_GT_ZERO,
+		ARRAY_FIELD_TYPE_NOT_ALLOWED,
+		DUPLICATED_FLAG_NAME,
+		ARGS_INDEX_NOT_ORDERED,
+		ARGS_INDEX_NOT_UNIQUE,
+		UNSATISFIABLE_CONSTRAINT,
+		VARIADIC_ARGUMENT_NOT_LAST,
+		REPLACED_BY_ITSELF,
+		BLANK_COMMAND_ID,
+		REQUIRED_COMMAND_NAME,
+		REQUIRED_COMMAND_DESCRIPTION,
+		BLANK_COMMAND_DESCRIPTION,
+		BLANK_ALIAS,
+		ALIAS_IN_USE,
+		UNRESOLVABLE_REPLACEMENT,
+		EXIT_IN_PARENT_COMMAND,
+		PARAMETERS_IN_PARENT_COMMAND,
+		REQUIRED_SCHEMA_TYPE,
+		PATTERN_IN_NON_STRING,
+		EXCLUSIVE_MAXIMUM_WITHOUT_MAXIMUM,
+		EXCLUSIVE_MINIMUM_WITHOUT_MINIMUM,
+		EXCLUSIVE_BOUNDARY_IN_NON_NUMBER,
+		ARRAY_FIELD_IN_NON_ARRAY,
+		REQUIRED_ARRAY_ITEMS,
+		FORMAT_IN_ARRAY,
+		REQUIRED_PARAMETER_NAME,
+		REQUIRED_PARAMETER_DESCRIPTION,
+		REQUIRED_PARAMETER_SCHEMA,
+		INDEX_IN_FLAGS,
+		SHORT_FORM_IN_ARGUMENTS,
+		DUPLICATED_ARGUMENT_NAME,
+		DUPLICATED_SHORT_FORM,
+		BLANK_ENV,
+		DUPLICATED_ENV,
+		REQUIRED_EXIT_CODE,
+		REQUIRED_EXIT_MESSAGE,
+		UNRESOLVABLE_CONSTRAINT_PARAMETER,
+		DUPLICATED_CONSTRAINT_PARAMETER,
+		CIRCULAR_SCHEMA_REFERENCE,
+	}
+}
+
+func GetRule(value string) *Rule {
+	for _, each := range GetRules() {
+		if each.Id == value || each.Name == value {
+			rule := each
+			return &rule
+		}
+	}
+	return nil
+}
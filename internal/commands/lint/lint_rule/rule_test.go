@@ -0,0 +1,30 @@
+package lint_rule
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetRules_where_id_and_name_are_unique(t *testing.T) {
+	ids := make(map[string]bool)
+	names := make(map[string]bool)
+
+	for _, each := range GetRules() {
+		assert.False(t, ids[each.Id], each.Id)
+		assert.False(t, names[each.Name], each.Name)
+		ids[each.Id] = true
+		names[each.Name] = true
+	}
+}
+
+func TestGetRule_where_id(t *testing.T) {
+	assert.Equal(t, &FIELD_MIN_LENGTH_MUST_NOT_BE_GT_MAX_LENGTH, GetRule("ANT0012"))
+}
+
+func TestGetRule_where_name(t *testing.T) {
+	assert.Equal(t, &FIELD_MIN_LENGTH_MUST_NOT_BE_GT_MAX_LENGTH, GetRule("min-length-gt-max-length"))
+}
+
+func TestGetRule_where_unknown(t *testing.T) {
+	assert.Nil(t, GetRule("ANT9999"))
+}
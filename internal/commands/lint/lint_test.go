@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/raitonbl/ant/internal"
 	"github.com/raitonbl/ant/internal/commands/lint/lint_message"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_rule"
 	"testing"
 )
 
@@ -284,3 +285,148 @@ func doLintFrom(t *testing.T, filename string, afterLint func(array []Violation)
 
 	afterLint(array)
 }
+
+func TestLint_where_violation_has_position(t *testing.T) {
+	doLintFrom(t, "index-018.json", func(array []Violation) {
+		if len(array) != 1 {
+			t.Fatal(fmt.Sprintf("\nExpected:1 violation\nActual:%s", toText(array)))
+		}
+
+		if array[0].File != "testdata/index-018.json" || array[0].Line == 0 || array[0].Column == 0 {
+			t.Fatal(fmt.Sprintf("position not resolved: %s:%d:%d", array[0].File, array[0].Line, array[0].Column))
+		}
+	})
+}
+
+func TestLint_where_violation_is_warning(t *testing.T) {
+	doLintFrom(t, "index-015.json", func(array []Violation) {
+		if len(array) != 1 || array[0].RuleId != lint_rule.FIELD_EXAMPLE_MUST_BE_PART_OF_ENUM.Id || array[0].Severity != lint_rule.Warning {
+			t.Fatal(fmt.Sprintf("\nExpected:[%s]\nActual:%s", lint_rule.FIELD_EXAMPLE_MUST_BE_PART_OF_ENUM.Id, toText(array)))
+		}
+
+		if IsFailure(array, false) {
+			t.Fatal("warning must not fail unless strict")
+		}
+
+		if !IsFailure(array, true) {
+			t.Fatal("warning must fail when strict")
+		}
+	})
+}
+
+func TestLint_where_violation_is_error(t *testing.T) {
+	doLintFrom(t, "index-018.json", func(array []Violation) {
+		if len(array) != 1 || array[0].RuleId != "ANT0012" || array[0].Severity != lint_rule.Error {
+			t.Fatal(fmt.Sprintf("\nExpected:[ANT0012]\nActual:%s", toText(array)))
+		}
+
+		if !IsFailure(array, false) {
+			t.Fatal("error must fail")
+		}
+	})
+}
+
+func TestLint_where_violations_are_suppressed(t *testing.T) {
+	doLintTest(t, "index-060.yaml")
+}
+
+func TestLint_where_suppression_belongs_to_another_object(t *testing.T) {
+	doLintTest(t, "index-061.yaml", Violation{Path: "/commands/0/parameters", Message: lint_message.ARGS_INDEX_NOT_ORDERED})
+}
+
+func TestLint_where_extension_isnt_valid(t *testing.T) {
+	doLintTest(t, "index-062.yaml", Violation{Path: "/exit/0/x-ant-lint-ignore", Message: "type should be array, got integer"})
+}
+
+func TestLint_where_checks_share_a_message(t *testing.T) {
+	for filename, rule := range map[string]lint_rule.Rule{
+		"index-001.json": lint_rule.SHORT_FORM_IN_ARGUMENTS,
+		"index-002.json": lint_rule.INDEX_IN_FLAGS,
+		"index-019.json": lint_rule.PATTERN_IN_NON_STRING,
+		"index-026.json": lint_rule.EXCLUSIVE_BOUNDARY_IN_NON_NUMBER,
+		"index-030.json": lint_rule.ARRAY_FIELD_IN_NON_ARRAY,
+		"index-034.json": lint_rule.FORMAT_IN_ARRAY,
+		"index-052.json": lint_rule.INDEX_IN_FLAGS,
+	} {
+		doLintFrom(t, filename, func(array []Violation) {
+			if len(array) != 1 || array[0].RuleId != rule.Id {
+				t.Fatal(fmt.Sprintf("\nExpected:[%s] from %s\nActual:%s", rule.Id, filename, toText(array)))
+			}
+		})
+	}
+}
+
+func TestLint_where_suppression_refers_to_schema_violation(t *testing.T) {
+	doLintTest(t, "index-078.yaml", Violation{Path: "/commands/0/parameters/0", Message: "did not match any of the specified OneOf schemas"})
+}
+
+func TestLint_where_refers_to_other_files(t *testing.T) {
+	doLintTest(t, "index-064.yaml")
+}
+
+func TestLint_where_refers_to_other_files_is_unresolvable(t *testing.T) {
+	doLintTest(t, "index-065.yaml", Violation{Path: "/commands/0/exit/0/refers-to", Message: lint_message.UNRESOLVABLE_FIELD},
+		Violation{Path: "/commands/0/parameters/0/refers-to", Message: lint_message.UNRESOLVABLE_FIELD},
+		Violation{Path: "/parameters/0/schema/min-length", Message: lint_message.FIELD_MIN_LENGTH_MUST_NOT_BE_GT_MAX_LENGTH})
+}
+
+func TestLint_where_document_has_no_parameters(t *testing.T) {
+	doLintTest(t, "index-077.yaml", Violation{Path: "/commands/0/exit/0/refers-to", Message: lint_message.UNRESOLVABLE_FIELD},
+		Violation{Path: "/commands/0/parameters/0/refers-to", Message: lint_message.UNRESOLVABLE_FIELD})
+}
+
+func TestLint_where_deprecated(t *testing.T) {
+	doLintTest(t, "index-068.yaml")
+}
+
+func TestLint_where_deprecated_is_replaced_by_unresolvable(t *testing.T) {
+	doLintTest(t, "index-069.yaml", Violation{Path: "/commands/1/parameters/0/deprecated/replaced-by", Message: lint_message.UNRESOLVABLE_FIELD},
+		Violation{Path: "/commands/1/parameters/1/deprecated/replaced-by", Message: lint_message.REPLACED_BY_ITSELF},
+		Violation{Path: "/commands/2/deprecated/replaced-by", Message: lint_message.UNRESOLVABLE_FIELD},
+		Violation{Path: "/commands/3/deprecated/replaced-by", Message: lint_message.REPLACED_BY_ITSELF})
+}
+
+func TestLint_where_aliases_collide(t *testing.T) {
+	doLintTest(t, "index-070.yaml", Violation{Path: "/commands/0/aliases/1", Message: lint_message.NOT_AVAILABLE_IN_USE},
+		Violation{Path: "/commands/2/aliases/0", Message: lint_message.NOT_AVAILABLE_IN_USE},
+		Violation{Path: "/commands/2/aliases/1", Message: lint_message.BLANK_FIELD},
+		Violation{Path: "/commands/0/commands/0/aliases/1", Message: lint_message.NOT_AVAILABLE_IN_USE},
+		Violation{Path: "/commands/0/commands/1/aliases/0", Message: lint_message.NOT_AVAILABLE_IN_USE})
+}
+
+func TestLint_where_env_is_duplicated(t *testing.T) {
+	doLintTest(t, "index-072.yaml", Violation{Path: "/commands/0/commands/0/parameters/2/env", Message: lint_message.DUPLICATED_FIELD_VALUE},
+		Violation{Path: "/commands/0/commands/1/parameters/1/env", Message: lint_message.BLANK_FIELD},
+		Violation{Path: "/commands/1/parameters/1/env", Message: lint_message.DUPLICATED_FIELD_VALUE})
+}
+
+func TestLint_where_constraints_are_invalid(t *testing.T) {
+	doLintTest(t, "index-074.yaml", Violation{Path: "/commands/1/constraints/exclusive/0/2", Message: lint_message.UNRESOLVABLE_FIELD},
+		Violation{Path: "/commands/1/constraints/required-together/0/2", Message: lint_message.DUPLICATED_FIELD_VALUE},
+		Violation{Path: "/commands/1/constraints/exclusive/1", Message: lint_message.UNSATISFIABLE_CONSTRAINT},
+		Violation{Path: "/commands/1/constraints/exclusive/2", Message: lint_message.UNSATISFIABLE_CONSTRAINT})
+}
+
+func TestLint_where_variadic_argument_isnt_last(t *testing.T) {
+	doLintTest(t, "index-075.yaml", Violation{Path: "/commands/1/parameters", Message: lint_message.VARIADIC_ARGUMENT_NOT_LAST})
+}
+
+func TestLint_where_arguments_have_no_id(t *testing.T) {
+	doLintTest(t, "index-076.yaml", Violation{Path: "/commands/0/parameters/2", Message: lint_message.NOT_AVAILABLE_IN_USE})
+}
+
+func TestLint_where_violation_belongs_to_other_file(t *testing.T) {
+	doLintFrom(t, "index-065.yaml", func(array []Violation) {
+		if len(array) != 3 {
+			t.Fatal(fmt.Sprintf("\nExpected:3 violations\nActual:%s", toText(array)))
+		}
+
+		if array[0].File != "testdata/index-065.yaml" || array[2].File != "testdata/shared/invalid.yaml" {
+			t.Fatal(fmt.Sprintf("file not resolved: %s, %s", array[0].File, array[2].File))
+		}
+
+		if array[2].Line != 8 || array[2].Column != 7 {
+			t.Fatal(fmt.Sprintf("position not resolved: %s:%d:%d", array[2].File, array[2].Line, array[2].Column))
+		}
+	})
+}
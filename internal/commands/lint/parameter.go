@@ -2,9 +2,9 @@ package lint
 
 import (
 	"fmt"
-	"github.com/raitonbl/ant/internal/commands/lint/lint_message"
-	"github.com/raitonbl/ant/internal/project"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_rule"
 	"github.com/raitonbl/ant/internal/utils"
+	"github.com/raitonbl/ant/pkg/project"
 	"github.com/thoas/go-funk"
 	"sort"
 )
@@ -15,12 +15,12 @@ type CommandCacheContext struct {
 	shortForms map[string]*project.Parameter
 }
 
-func doLintParameterSection(document *project.Specification, schemaCache map[string]*project.Schema) (map[string]*project.Parameter, []Violation, error) {
+func doLintParameterSection(document *project.Specification, schemaCache map[string]*project.Schema) ([]Violation, error) {
 	problems := make([]Violation, 0)
 	cache := make(map[string]*project.Parameter)
 
 	if document.Parameters == nil {
-		return cache, problems, nil
+		return problems, nil
 	}
 
 	for index, parameter := range document.Parameters {
@@ -28,17 +28,17 @@ func doLintParameterSection(document *project.Specification, schemaCache map[str
 		ctx := &LintContext{prefix: fmt.Sprintf("/parameters/%d", index), document: document, schemas: schemaCache}
 
 		if parameter.Id == nil || utils.IsBlank(*parameter.Id) {
-			problems = append(problems, Violation{Path: fmt.Sprintf("%s/id", ctx.prefix), Message: lint_message.REQUIRED_FIELD})
+			problems = append(problems, newViolation(fmt.Sprintf("%s/id", ctx.prefix), lint_rule.REQUIRED_ID))
 		}
 
 		if cache[*parameter.Id] != nil {
-			problems = append(problems, Violation{Path: fmt.Sprintf("%s/id", ctx.prefix), Message: lint_message.DUPLICATED_FIELD_VALUE})
+			problems = append(problems, newViolation(fmt.Sprintf("%s/id", ctx.prefix), lint_rule.DUPLICATED_ID))
 		}
 
 		array, prob := doLintParameter(ctx, &parameter)
 
 		if prob != nil {
-			return nil, nil, prob
+			return nil, prob
 		}
 
 		problems = append(problems, array...)
@@ -49,7 +49,7 @@ func doLintParameterSection(document *project.Specification, schemaCache map[str
 
 	}
 
-	return cache, problems, nil
+	return problems, nil
 }
 
 func doLintCommandParameterSection(commandContext *CommandLintingContext, document *project.Specification, instance *project.Command) ([]Violation, error) {
@@ -92,6 +92,14 @@ func doLintCommandParameters(commandContext *CommandLintingContext, instance *pr
 
 	}
 
+	envs := make(map[string]bool)
+
+	for index, each := range instance.Parameters {
+		ctx := &LintContext{prefix: fmt.Sprintf("%s/parameters/%d", prefix, index), document: document, schemas: commandContext.schemaCache}
+		problems = append(problems, doLintCommandParameterDeprecation(ctx, cacheContext, each)...)
+		problems = append(problems, doLintCommandParameterEnv(ctx, envs, each)...)
+	}
+
 	ctx := &LintContext{prefix: fmt.Sprintf("%s/parameters", prefix), document: document, schemas: commandContext.schemaCache}
 	problems = append(problems, doLintCommandParameterInArguments(ctx, args)...)
 
@@ -114,11 +122,9 @@ func doLintCommandParameter(commandContext *CommandLintingContext, ctx *LintCont
 	}
 
 	if param.In != nil && *param.In == project.Arguments && param.Name != nil && args[*param.Name] != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s", ctx.prefix), Message: lint_message.NOT_AVAILABLE_IN_USE})
-	}
-
-	if param.In != nil && *param.In == project.Arguments {
-		args[*param.Id] = param
+		problems = append(problems, newViolation(fmt.Sprintf("%s", ctx.prefix), lint_rule.DUPLICATED_ARGUMENT_NAME))
+	} else if param.In != nil && *param.In == project.Arguments && param.Name != nil {
+		args[*param.Name] = param
 	}
 
 	if param.In == nil || *param.In == project.Flags {
@@ -143,19 +149,18 @@ func doLintCommandParameterRefersTo(commandContext *CommandLintingContext, ctx *
 	skipLintParameter := false
 	problems := make([]Violation, 0)
 	isReference := isParameterReference(param)
-	parameterCache := commandContext.parameterCache
 
 	if each.RefersTo != nil && !isReference {
 		param = nil
-		problems = append(problems, Violation{Path: fmt.Sprintf(refers_to_format_pattern, ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+		problems = append(problems, newViolation(fmt.Sprintf(refers_to_format_pattern, ctx.prefix), lint_rule.REFERS_TO_WITH_DEFINITION))
 	} else if each.RefersTo != nil && isReference {
-		param = parameterCache[*each.RefersTo]
+		param = commandContext.document.GetParameter(*each.RefersTo)
 
 		if param == nil {
-			problems = append(problems, Violation{Path: fmt.Sprintf(refers_to_format_pattern, ctx.prefix), Message: lint_message.UNRESOLVABLE_FIELD})
+			problems = append(problems, newViolation(fmt.Sprintf(refers_to_format_pattern, ctx.prefix), lint_rule.UNRESOLVABLE_REFERENCE))
 			param = nil
 		} else if (param.In == nil || *param.In == project.Flags) && each.Index != nil {
-			problems = append(problems, Violation{Path: fmt.Sprintf(refers_to_format_pattern, ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+			problems = append(problems, newViolation(fmt.Sprintf(refers_to_format_pattern, ctx.prefix), lint_rule.INDEX_IN_FLAGS))
 			param = nil
 		} else if param.In != nil && *param.In == project.Arguments && each.Index != nil {
 			param = param.Clone()
@@ -177,13 +182,13 @@ func doLintCommandParameterInFlags(ctx *LintContext, param *project.Parameter, f
 	problems := make([]Violation, 0)
 
 	if param.Name != nil && flags[*param.Name] != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf(name_format_pattern, ctx.prefix), Message: lint_message.NOT_AVAILABLE_IN_USE})
+		problems = append(problems, newViolation(fmt.Sprintf(name_format_pattern, ctx.prefix), lint_rule.DUPLICATED_FLAG_NAME))
 	} else if param.Name != nil {
 		flags[*param.Name] = param
 	}
 
 	if param.ShortForm != nil && shortForms[*param.ShortForm] != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/short-form", ctx.prefix), Message: lint_message.DUPLICATED_FIELD_VALUE})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/short-form", ctx.prefix), lint_rule.DUPLICATED_SHORT_FORM))
 	} else if param.ShortForm != nil {
 		shortForms[*param.ShortForm] = param
 	}
@@ -191,6 +196,58 @@ func doLintCommandParameterInFlags(ctx *LintContext, param *project.Parameter, f
 	return problems
 }
 
+// doLintCommandParameterDeprecation verifies that the parameter which replaces a deprecated one is a parameter of the same command
+func doLintCommandParameterDeprecation(ctx *LintContext, cacheContext *CommandCacheContext, each project.Parameter) []Violation {
+	problems := make([]Violation, 0)
+	param := &each
+
+	if each.RefersTo != nil {
+		param = ctx.document.GetParameter(*each.RefersTo)
+	}
+
+	if param == nil || param.Deprecated == nil || param.Deprecated.ReplacedBy == nil {
+		return problems
+	}
+
+	name := *param.Deprecated.ReplacedBy
+
+	if cacheContext.args[name] == nil && cacheContext.flags[name] == nil {
+		problems = append(problems, newViolation(fmt.Sprintf(replaced_by_format_pattern, ctx.prefix), lint_rule.UNRESOLVABLE_REPLACEMENT))
+	} else if name == param.GetName() {
+		problems = append(problems, newViolation(fmt.Sprintf(replaced_by_format_pattern, ctx.prefix), lint_rule.REPLACED_BY_ITSELF))
+	}
+
+	return problems
+}
+
+// doLintCommandParameterEnv verifies that the environment variable of the parameter isn't bound to another parameter of the command
+func doLintCommandParameterEnv(ctx *LintContext, envs map[string]bool, each project.Parameter) []Violation {
+	problems := make([]Violation, 0)
+	param := &each
+
+	if each.RefersTo != nil {
+		param = ctx.document.GetParameter(*each.RefersTo)
+	}
+
+	if param == nil || param.Env == nil {
+		return problems
+	}
+
+	if utils.IsBlank(*param.Env) {
+		return append(problems, newViolation(fmt.Sprintf(env_format_pattern, ctx.prefix), lint_rule.BLANK_ENV))
+	}
+
+	name := ctx.document.GetEnv(param)
+
+	if envs[name] {
+		problems = append(problems, newViolation(fmt.Sprintf(env_format_pattern, ctx.prefix), lint_rule.DUPLICATED_ENV))
+	}
+
+	envs[name] = true
+
+	return problems
+}
+
 func doLintCommandParameterInArguments(ctx *LintContext, args map[string]*project.Parameter) []Violation {
 	problems := make([]Violation, 0)
 	seq := make([]*project.Parameter, 0)
@@ -204,21 +261,33 @@ func doLintCommandParameterInArguments(ctx *LintContext, args map[string]*projec
 	sort.Sort(ArgParameter(seq))
 
 	indexes := make([]int, 0)
+	isVariadic := false
 
 	for _, each := range seq {
 
 		if len(indexes) == 0 && *each.Index != 0 {
-			problems = append(problems, Violation{Path: fmt.Sprintf("%s", ctx.prefix), Message: lint_message.ARGS_INDEX_NOT_ORDERED})
+			problems = append(problems, newViolation(fmt.Sprintf("%s", ctx.prefix), lint_rule.ARGS_INDEX_NOT_ORDERED))
 		} else if funk.Contains(indexes, *each.Index) {
-			problems = append(problems, Violation{Path: fmt.Sprintf("%s", ctx.prefix), Message: lint_message.ARGS_INDEX_NOT_UNIQUE})
+			problems = append(problems, newViolation(fmt.Sprintf("%s", ctx.prefix), lint_rule.ARGS_INDEX_NOT_UNIQUE))
+		} else if isVariadic {
+			problems = append(problems, newViolation(fmt.Sprintf("%s", ctx.prefix), lint_rule.VARIADIC_ARGUMENT_NOT_LAST))
 		}
 
 		indexes = append(indexes, *each.Index)
+		isVariadic = isVariadic || isVariadicArgument(ctx, each)
 	}
 
 	return problems
 }
 
+// isVariadicArgument determines whether the argument is variadic, following the refers-to of its schema
+func isVariadicArgument(ctx *LintContext, param *project.Parameter) bool {
+	object := *param
+	object.Schema = ctx.document.ResolveSchema(param.Schema)
+
+	return object.IsVariadic()
+}
+
 func isParameterReference(each *project.Parameter) bool {
 
 	if each.Id != nil {
@@ -249,10 +318,18 @@ func isParameterReference(each *project.Parameter) bool {
 		return false
 	}
 
+	if each.Env != nil {
+		return false
+	}
+
 	if each.Schema != nil {
 		return false
 	}
 
+	if each.Deprecated != nil {
+		return false
+	}
+
 	if each.Index != nil && each.RefersTo == nil {
 		return false
 	}
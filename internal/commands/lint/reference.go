@@ -0,0 +1,137 @@
+package lint
+
+import (
+	"encoding/json"
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/pkg/project"
+	"sort"
+)
+
+// doLintReferences lints every file imported through refers-to, reporting violations against the imported file
+func doLintReferences(ctx internal.ProjectContext) ([]Violation, error) {
+	document, err := ctx.GetDocument()
+
+	if err != nil {
+		return nil, err
+	}
+
+	filenames := make([]string, 0, len(document.Imports))
+
+	for filename := range document.Imports {
+		filenames = append(filenames, filename)
+	}
+
+	sort.Strings(filenames)
+
+	problems := make([]Violation, 0)
+	visited := map[internal.ProjectContext]bool{ctx: true}
+
+	for _, filename := range filenames {
+		reference, err := ctx.GetReference(filename)
+
+		if err != nil || visited[reference] {
+			continue
+		}
+
+		visited[reference] = true
+		array, err := doLintReference(reference)
+
+		if err != nil {
+			return nil, err
+		}
+
+		problems = append(problems, array...)
+	}
+
+	return problems, nil
+}
+
+func doLintReference(ctx internal.ProjectContext) ([]Violation, error) {
+	binary, err := getBinary(ctx.GetProjectFile())
+
+	if err != nil {
+		return nil, err
+	}
+
+	array, err := doLintFile(binary, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(array) == 0 {
+		array, err = doLintReferenceObject(ctx)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	suppressions, err := getSuppressions(binary)
+
+	if err != nil {
+		return nil, err
+	}
+
+	array = suppress(suppressions, array)
+
+	for index := range array {
+		setPosition(ctx.GetProjectFile(), &array[index])
+	}
+
+	return array, nil
+}
+
+func doLintReferenceObject(ctx internal.ProjectContext) ([]Violation, error) {
+	document, err := ctx.GetDocument()
+
+	if err != nil {
+		return nil, err
+	}
+
+	problems := make([]Violation, 0)
+	schemaCache, array, err := doLintSchemaSection(document)
+
+	if err != nil {
+		return nil, err
+	}
+
+	problems = append(problems, array...)
+
+	array, err = doLintParameterSection(document, schemaCache)
+
+	if err != nil {
+		return nil, err
+	}
+
+	problems = append(problems, array...)
+
+	array, err = doLintExitSection(document)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(problems, array...), nil
+}
+
+func getReferenceSchema(binary []byte) ([]byte, error) {
+	schema := make(map[string]interface{})
+
+	if err := json.Unmarshal(binary, &schema); err != nil {
+		return nil, err
+	}
+
+	delete(schema, "required")
+
+	return json.Marshal(schema)
+}
+
+func getSchema(ctx *LintContext, id string) *project.Schema {
+
+	if project.IsExternalReference(id) && ctx.document != nil {
+		return ctx.document.GetSchema(id)
+	}
+
+	return ctx.schemas[id]
+}
@@ -0,0 +1,278 @@
+package lint
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_rule"
+)
+
+type Format string
+
+const (
+	Text       Format = "text"
+	Json       Format = "json"
+	Sarif      Format = "sarif"
+	JUnit      Format = "junit"
+	Checkstyle Format = "checkstyle"
+)
+
+const sarif_schema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+func Report(format Format, filename string, problems []Violation) ([]byte, error) {
+
+	if problems == nil {
+		problems = make([]Violation, 0)
+	}
+
+	switch format {
+	case Text:
+		return doReportText(problems), nil
+	case Json:
+		return json.MarshalIndent(JsonReport{File: filename, Violations: problems}, "", "  ")
+	case Sarif:
+		return doReportSarif(filename, problems)
+	case JUnit:
+		return doReportJUnit(filename, problems)
+	case Checkstyle:
+		return doReportCheckstyle(filename, problems)
+	default:
+		return nil, internal.GetProblemFactory().GetUnsupportedFormat(string(format))
+	}
+}
+
+func getLocation(violation Violation) string {
+
+	if violation.Line == 0 {
+		return violation.File
+	}
+
+	return fmt.Sprintf("%s:%d:%d", violation.File, violation.Line, violation.Column)
+}
+
+func getFile(filename string, violation Violation) string {
+
+	if violation.File == "" {
+		return filename
+	}
+
+	return violation.File
+}
+
+func doReportText(problems []Violation) []byte {
+	txt := ""
+
+	for _, each := range problems {
+		txt += fmt.Sprintf("%s: %s %s: %s (%s)\n", getLocation(each), each.Severity, each.RuleId, each.Message, each.Path)
+	}
+
+	return []byte(txt)
+}
+
+type JsonReport struct {
+	File       string      `json:"file"`
+	Violations []Violation `json:"violations"`
+}
+
+type SarifReport struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SarifRun `json:"runs"`
+}
+
+type SarifRun struct {
+	Tool    SarifTool     `json:"tool"`
+	Results []SarifResult `json:"results"`
+}
+
+type SarifTool struct {
+	Driver SarifDriver `json:"driver"`
+}
+
+type SarifDriver struct {
+	Name           string      `json:"name"`
+	InformationUri string      `json:"informationUri"`
+	Rules          []SarifRule `json:"rules"`
+}
+
+type SarifRule struct {
+	Id               string             `json:"id"`
+	Name             string             `json:"name"`
+	ShortDescription SarifMessage       `json:"shortDescription"`
+	Configuration    SarifConfiguration `json:"defaultConfiguration"`
+}
+
+type SarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type SarifResult struct {
+	RuleId    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   SarifMessage    `json:"message"`
+	Locations []SarifLocation `json:"locations"`
+}
+
+type SarifMessage struct {
+	Text string `json:"text"`
+}
+
+type SarifLocation struct {
+	PhysicalLocation SarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []SarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type SarifPhysicalLocation struct {
+	ArtifactLocation SarifArtifactLocation `json:"artifactLocation"`
+	Region           *SarifRegion          `json:"region,omitempty"`
+}
+
+type SarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+}
+
+type SarifArtifactLocation struct {
+	Uri string `json:"uri"`
+}
+
+type SarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+func doReportSarif(filename string, problems []Violation) ([]byte, error) {
+	results := make([]SarifResult, 0)
+
+	for _, each := range problems {
+		location := SarifLocation{PhysicalLocation: SarifPhysicalLocation{ArtifactLocation: SarifArtifactLocation{Uri: getFile(filename, each)}}}
+
+		if each.Line > 0 {
+			location.PhysicalLocation.Region = &SarifRegion{StartLine: each.Line, StartColumn: each.Column}
+		}
+
+		if each.Path != "" {
+			location.LogicalLocations = []SarifLogicalLocation{{FullyQualifiedName: each.Path}}
+		}
+
+		results = append(results, SarifResult{RuleId: each.RuleId, Level: getSarifLevel(each.Severity), Message: SarifMessage{Text: each.Message}, Locations: []SarifLocation{location}})
+	}
+
+	rules := make([]SarifRule, 0)
+
+	for _, each := range lint_rule.GetRules() {
+		rules = append(rules, SarifRule{Id: each.Id, Name: each.Name, ShortDescription: SarifMessage{Text: each.Message},
+			Configuration: SarifConfiguration{Level: getSarifLevel(each.Severity)}})
+	}
+
+	report := SarifReport{Schema: sarif_schema, Version: "2.1.0", Runs: []SarifRun{{
+		Tool:    SarifTool{Driver: SarifDriver{Name: "ant", InformationUri: "https://github.com/raitonbl/ant", Rules: rules}},
+		Results: results,
+	}}}
+
+	return json.MarshalIndent(report, "", "  ")
+}
+
+func getSarifLevel(severity lint_rule.Severity) string {
+
+	if severity == lint_rule.Info {
+		return "note"
+	}
+
+	return string(severity)
+}
+
+type JUnitReport struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []JUnitSuite `xml:"testsuite"`
+}
+
+type JUnitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Cases    []JUnitCase `xml:"testcase"`
+}
+
+type JUnitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *JUnitFailure `xml:"failure,omitempty"`
+}
+
+type JUnitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func doReportJUnit(filename string, problems []Violation) ([]byte, error) {
+	suite := JUnitSuite{Name: "ant lint", Tests: len(problems), Failures: len(problems), Cases: make([]JUnitCase, 0)}
+
+	for _, each := range problems {
+		suite.Cases = append(suite.Cases, JUnitCase{Name: each.Path, ClassName: getFile(filename, each),
+			Failure: &JUnitFailure{Message: each.Message, Type: each.RuleId, Text: fmt.Sprintf("%s: %s %s: %s (%s)", getLocation(each), each.Severity, each.RuleId, each.Message, each.Path)}})
+	}
+
+	if len(problems) == 0 {
+		suite.Tests = 1
+		suite.Cases = append(suite.Cases, JUnitCase{Name: filename, ClassName: filename})
+	}
+
+	return doMarshalXml(JUnitReport{Suites: []JUnitSuite{suite}})
+}
+
+type CheckstyleReport struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []CheckstyleFile `xml:"file"`
+}
+
+type CheckstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []CheckstyleError `xml:"error"`
+}
+
+type CheckstyleError struct {
+	Line     int    `xml:"line,attr,omitempty"`
+	Column   int    `xml:"column,attr,omitempty"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+func doReportCheckstyle(filename string, problems []Violation) ([]byte, error) {
+	files := []CheckstyleFile{{Name: filename, Errors: make([]CheckstyleError, 0)}}
+
+	for _, each := range problems {
+		index := indexOfCheckstyleFile(files, getFile(filename, each))
+
+		if index == len(files) {
+			files = append(files, CheckstyleFile{Name: getFile(filename, each), Errors: make([]CheckstyleError, 0)})
+		}
+
+		files[index].Errors = append(files[index].Errors, CheckstyleError{Line: each.Line, Column: each.Column, Severity: string(each.Severity),
+			Message: fmt.Sprintf("%s (%s)", each.Message, each.Path), Source: fmt.Sprintf("ant.%s", each.RuleId)})
+	}
+
+	return doMarshalXml(CheckstyleReport{Version: "4.3", Files: files})
+}
+
+func indexOfCheckstyleFile(files []CheckstyleFile, name string) int {
+	for index, each := range files {
+		if each.Name == name {
+			return index
+		}
+	}
+	return len(files)
+}
+
+func doMarshalXml(value interface{}) ([]byte, error) {
+	binary, err := xml.MarshalIndent(value, "", "  ")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), binary...), nil
+}
@@ -0,0 +1,119 @@
+package lint
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_message"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_rule"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+var reportViolations = []Violation{
+	{Path: "/parameters/0/name", Message: lint_message.REQUIRED_FIELD, RuleId: "ANT0044", Severity: lint_rule.Error, File: "index.json", Line: 56, Column: 5},
+	{Path: "/parameters/1/schema/examples/0", Message: lint_message.FIELD_EXAMPLE_MUST_BE_PART_OF_ENUM, RuleId: "ANT0011", Severity: lint_rule.Warning, File: "index.json"},
+}
+
+func TestReport_where_format_is_text(t *testing.T) {
+	binary, err := Report(Text, "index.json", reportViolations)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "index.json:56:5: error ANT0044: field is required (/parameters/0/name)\nindex.json: warning ANT0011: example must be contained in Enum (/parameters/1/schema/examples/0)\n", string(binary))
+}
+
+func TestReport_where_format_is_json(t *testing.T) {
+	binary, err := Report(Json, "index.json", reportViolations)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := JsonReport{}
+
+	if err = json.Unmarshal(binary, &report); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, JsonReport{File: "index.json", Violations: reportViolations}, report)
+}
+
+func TestReport_where_format_is_sarif(t *testing.T) {
+	binary, err := Report(Sarif, "index.json", reportViolations)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := SarifReport{}
+
+	if err = json.Unmarshal(binary, &report); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "2.1.0", report.Version)
+	assert.Equal(t, len(lint_rule.GetRules()), len(report.Runs[0].Tool.Driver.Rules))
+	assert.Equal(t, "ANT0011", report.Runs[0].Results[1].RuleId)
+	assert.Equal(t, "warning", report.Runs[0].Results[1].Level)
+	assert.Equal(t, 2, len(report.Runs[0].Results))
+	assert.Equal(t, "index.json", report.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation.Uri)
+	assert.Equal(t, "/parameters/0/name", report.Runs[0].Results[0].Locations[0].LogicalLocations[0].FullyQualifiedName)
+	assert.Equal(t, &SarifRegion{StartLine: 56, StartColumn: 5}, report.Runs[0].Results[0].Locations[0].PhysicalLocation.Region)
+	assert.Nil(t, report.Runs[0].Results[1].Locations[0].PhysicalLocation.Region)
+}
+
+func TestReport_where_format_is_junit(t *testing.T) {
+	binary, err := Report(JUnit, "index.json", reportViolations)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := JUnitReport{}
+
+	if err = xml.Unmarshal(binary, &report); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 2, report.Suites[0].Failures)
+	assert.Equal(t, lint_message.REQUIRED_FIELD, report.Suites[0].Cases[0].Failure.Message)
+}
+
+func TestReport_where_format_is_junit_and_document_is_valid(t *testing.T) {
+	binary, err := Report(JUnit, "index.json", nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.True(t, strings.Contains(string(binary), `<testsuite name="ant lint" tests="1" failures="0">`))
+}
+
+func TestReport_where_format_is_checkstyle(t *testing.T) {
+	binary, err := Report(Checkstyle, "index.json", reportViolations)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := CheckstyleReport{}
+
+	if err = xml.Unmarshal(binary, &report); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "index.json", report.Files[0].Name)
+	assert.Equal(t, 2, len(report.Files[0].Errors))
+	assert.Equal(t, 56, report.Files[0].Errors[0].Line)
+	assert.Equal(t, "warning", report.Files[0].Errors[1].Severity)
+	assert.Equal(t, "ant.ANT0011", report.Files[0].Errors[1].Source)
+}
+
+func TestReport_where_format_is_unknown(t *testing.T) {
+	_, err := Report(Format("yaml"), "index.json", reportViolations)
+
+	assert.NotNil(t, err)
+}
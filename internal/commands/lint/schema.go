@@ -2,9 +2,9 @@ package lint
 
 import (
 	"fmt"
-	"github.com/raitonbl/ant/internal/commands/lint/lint_message"
-	"github.com/raitonbl/ant/internal/project"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_rule"
 	"github.com/raitonbl/ant/internal/utils"
+	"github.com/raitonbl/ant/pkg/project"
 	"github.com/thoas/go-funk"
 )
 
@@ -48,23 +48,27 @@ func doLintSchemaFromSchemaSection(ctx *LintContext, fromConfig map[string]*proj
 	problems := make([]Violation, 0)
 
 	if schema.Id == nil || utils.IsBlank(*schema.Id) {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/id", ctx.prefix), Message: lint_message.REQUIRED_FIELD})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/id", ctx.prefix), lint_rule.REQUIRED_ID))
 	}
 
 	if schema.RefersTo != nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf(refers_to_format_pattern, ctx.prefix), Message: lint_message.FIELD_NOT_ALLOWED})
+		problems = append(problems, newViolation(fmt.Sprintf(refers_to_format_pattern, ctx.prefix), lint_rule.REFERS_TO_WITH_DEFINITION))
 	}
 
 	if schema.TypeOf == nil {
-		problems = append(problems, Violation{Path: fmt.Sprintf("%s/type", ctx.prefix), Message: lint_message.REQUIRED_FIELD})
+		problems = append(problems, newViolation(fmt.Sprintf("%s/type", ctx.prefix), lint_rule.REQUIRED_SCHEMA_TYPE))
 	}
 
 	if *schema.TypeOf == project.Array && schema.Items != nil && schema.Items.RefersTo != nil {
 
-		if fromConfig[*schema.Items.RefersTo] == nil {
-			problems = append(problems, Violation{Path: fmt.Sprintf(refers_to_format_pattern, ctx.prefix), Message: lint_message.UNRESOLVABLE_FIELD})
+		if project.IsExternalReference(*schema.Items.RefersTo) {
+			if ctx.document.GetSchema(*schema.Items.RefersTo) == nil {
+				problems = append(problems, newViolation(fmt.Sprintf(refers_to_format_pattern, ctx.prefix), lint_rule.UNRESOLVABLE_REFERENCE))
+			}
+		} else if fromConfig[*schema.Items.RefersTo] == nil {
+			problems = append(problems, newViolation(fmt.Sprintf(refers_to_format_pattern, ctx.prefix), lint_rule.UNRESOLVABLE_REFERENCE))
 		} else if funk.Contains(keys, *schema.Items.RefersTo) {
-			problems = append(problems, Violation{Path: fmt.Sprintf(refers_to_format_pattern, ctx.prefix), Message: lint_message.UNRESOLVABLE_FIELD})
+			problems = append(problems, newViolation(fmt.Sprintf(refers_to_format_pattern, ctx.prefix), lint_rule.CIRCULAR_SCHEMA_REFERENCE))
 		} else {
 			keys = append(keys, *schema.Items.RefersTo)
 			newCtx := &LintContext{prefix: fmt.Sprintf("/schemas/%d", funk.IndexOf(ctx.document.Schemas, schema)), document: ctx.document, schemas: ctx.schemas}
@@ -0,0 +1,73 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_rule"
+	"strings"
+)
+
+const lint_ignore_extension = "x-ant-lint-ignore"
+
+// getSuppressions maps the JSON pointer of each object annotated with x-ant-lint-ignore into the rules it suppresses
+func getSuppressions(binary []byte) (map[string][]string, error) {
+	var document interface{}
+
+	if err := json.Unmarshal(binary, &document); err != nil {
+		return nil, err
+	}
+
+	cache := make(map[string][]string)
+	doGetSuppressions("", document, cache)
+
+	return cache, nil
+}
+
+func doGetSuppressions(pointer string, value interface{}, cache map[string][]string) {
+	switch object := value.(type) {
+	case map[string]interface{}:
+		if array, isArray := object[lint_ignore_extension].([]interface{}); isArray {
+			for _, each := range array {
+				if id, isText := each.(string); isText {
+					cache[pointer] = append(cache[pointer], id)
+				}
+			}
+		}
+
+		for key, each := range object {
+			doGetSuppressions(fmt.Sprintf("%s/%s", pointer, strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")), each, cache)
+		}
+	case []interface{}:
+		for index, each := range object {
+			doGetSuppressions(fmt.Sprintf("%s/%d", pointer, index), each, cache)
+		}
+	}
+}
+
+func suppress(cache map[string][]string, problems []Violation) []Violation {
+	array := make([]Violation, 0)
+
+	for _, each := range problems {
+		if isMandatory(each) || !isSuppressed(cache, each) {
+			array = append(array, each)
+		}
+	}
+
+	return array
+}
+
+func isSuppressed(cache map[string][]string, violation Violation) bool {
+	for pointer, rules := range cache {
+
+		if violation.Path != pointer && !strings.HasPrefix(violation.Path, pointer+"/") {
+			continue
+		}
+
+		for _, each := range rules {
+			if rule := lint_rule.GetRule(each); rule != nil && rule.Id == violation.RuleId {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -2,14 +2,17 @@ package internal
 
 import (
 	"encoding/json"
-	"github.com/raitonbl/ant/internal/project"
+	"github.com/raitonbl/ant/pkg/project"
 	"gopkg.in/yaml.v3"
+	"path"
+	"path/filepath"
 	"strings"
 )
 
 type ProjectContext interface {
 	GetProjectFile() *File
 	GetDocument() (*project.Specification, error)
+	GetReference(filename string) (ProjectContext, error)
 }
 
 func GetContext(filename string) (ProjectContext, error) {
@@ -19,13 +22,46 @@ func GetContext(filename string) (ProjectContext, error) {
 		return nil, err
 	}
 
-	return &DefaultContext{projectFile: file}, nil
+	ctx := &DefaultContext{projectFile: file, references: make(map[string]*DefaultContext)}
+
+	if absolute, err := filepath.Abs(filename); err == nil {
+		ctx.references[absolute] = ctx
+	}
+
+	return ctx, nil
+}
+
+// NewContext creates a context out of a document which isn't read from the file system. The filename
+// determines how the document is parsed, as well as where the files it refers to are looked up
+func NewContext(filename string, binary []byte) ProjectContext {
+	return &DefaultContext{projectFile: &File{path: filename, content: binary}, references: make(map[string]*DefaultContext)}
+}
+
+// GetDocument returns the document of the context, failing when there's no context or it has no document
+func GetDocument(ctx ProjectContext) (*project.Specification, error) {
+
+	if ctx == nil {
+		return nil, GetProblemFactory().GetUnexpectedContext()
+	}
+
+	document, err := ctx.GetDocument()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if document == nil {
+		return nil, GetProblemFactory().GetUnexpectedState()
+	}
+
+	return document, nil
 }
 
 type DefaultContext struct {
 	projectFile       *File
 	processedDocument *project.Specification
 	document          *project.Specification
+	references        map[string]*DefaultContext
 }
 
 func (instance *DefaultContext) GetProjectFile() *File {
@@ -50,11 +86,89 @@ func (instance *DefaultContext) GetDocument() (*project.Specification, error) {
 
 	binary := instance.GetProjectFile().GetContent()
 
+	var document *project.Specification
+	var err error
+
 	if strings.HasSuffix(filename, ".json") {
-		return parseJson(binary)
+		document, err = parseJson(binary)
+	} else {
+		document, err = parseYaml(binary)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	instance.document = document
+	instance.doLoadReferences(document)
+
+	return document, nil
+}
+
+// GetReference returns the context of a file referenced by the document, relative to the project file
+func (instance *DefaultContext) GetReference(filename string) (ProjectContext, error) {
+
+	if instance.GetProjectFile() == nil {
+		return nil, GetProblemFactory().GetConfigurationFileNotFound()
+	}
+
+	if instance.references == nil {
+		instance.references = make(map[string]*DefaultContext)
+	}
+
+	name := filepath.Join(filepath.Dir(instance.GetProjectFile().GetName()), filepath.FromSlash(filename))
+	absolute, err := filepath.Abs(name)
+
+	if err != nil {
+		return nil, GetProblemFactory().GetFileCannotBeOpened(name, err)
+	}
+
+	if ctx := instance.references[absolute]; ctx != nil {
+		return ctx, nil
 	}
 
-	return parseYaml(binary)
+	file, err := GetFile(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := &DefaultContext{projectFile: file, references: instance.references}
+	instance.references[absolute] = ctx
+
+	return ctx, nil
+}
+
+// doLoadReferences imports every referenced document, including the ones they reference in turn.
+// References which cannot be loaded are left out, so that lint reports them as unresolvable
+func (instance *DefaultContext) doLoadReferences(document *project.Specification) {
+
+	for _, filename := range document.GetReferencedFiles() {
+		ctx, err := instance.GetReference(filename)
+
+		if err != nil {
+			continue
+		}
+
+		imported, err := ctx.GetDocument()
+
+		if err != nil {
+			continue
+		}
+
+		if document.Imports == nil {
+			document.Imports = make(map[string]*project.Specification)
+		}
+
+		key := path.Clean(filename)
+		document.Imports[key] = imported
+
+		for name, each := range imported.Imports {
+			if nested := path.Join(path.Dir(key), name); document.Imports[nested] == nil {
+				document.Imports[nested] = each
+			}
+		}
+	}
 }
 
 func parseYaml(binary []byte) (*project.Specification, error) {
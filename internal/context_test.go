@@ -1,6 +1,11 @@
 package internal
 
-import "testing"
+import (
+	"github.com/raitonbl/ant/pkg/project"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
 
 func TestGetContext_where_json_exits(t *testing.T) {
 	ctx, err := GetContext("commands/lint/testdata/index-003.json")
@@ -57,3 +62,233 @@ func TestGetContext_where_yaml_doesnt_exit(t *testing.T) {
 	}
 
 }
+
+func TestFile_GetPosition_where_yaml(t *testing.T) {
+	file, err := GetFile("commands/lint/testdata/index-003.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doTestPosition(t, file, "/commands/1/commands/0/parameters/1", 21, 13)
+	doTestPosition(t, file, "/parameters/1/schema/enum", 43, 7)
+	doTestPosition(t, file, "/parameters/1/schema/missing", 42, 5)
+}
+
+func TestFile_GetPosition_where_json(t *testing.T) {
+	file, err := GetFile("commands/lint/testdata/index-005.json")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doTestPosition(t, file, "/parameters/0/name", 56, 5)
+}
+
+func doTestPosition(t *testing.T, file *File, pointer string, line int, column int) {
+	position := file.GetPosition(pointer)
+
+	if position == nil {
+		t.Fatal("GetPosition() returned nil")
+	}
+
+	if position.Line != line || position.Column != column {
+		t.Fatalf("%s: expected %d:%d, actual %d:%d", pointer, line, column, position.Line, position.Column)
+	}
+}
+
+func TestGetContext_where_json_has_extensions(t *testing.T) {
+	doTestExtensions(t, "commands/lint/testdata/index-063.json")
+}
+
+func TestGetContext_where_yaml_has_extensions(t *testing.T) {
+	doTestExtensions(t, "commands/lint/testdata/index-063.yaml")
+}
+
+func TestSerialize_where_json_has_extensions(t *testing.T) {
+	doTestSerialize(t, "commands/lint/testdata/index-063.yaml", "index.json")
+}
+
+func TestSerialize_where_yaml_has_extensions(t *testing.T) {
+	doTestSerialize(t, "commands/lint/testdata/index-063.json", "index.yaml")
+}
+
+func TestSerialize_where_format_is_unsupported(t *testing.T) {
+	ctx, err := GetContext("commands/lint/testdata/index-063.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	document, err := ctx.GetDocument()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = Serialize(document, "index.toml"); err == nil {
+		t.Fatal("error not caught")
+	}
+
+}
+
+func doTestSerialize(t *testing.T, filename string, target string) {
+	ctx, err := GetContext(filename)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	document, err := ctx.GetDocument()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	binary, err := Serialize(document, target)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.HasSuffix(target, ".json") {
+		document, err = parseJson(binary)
+	} else {
+		document, err = parseYaml(binary)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doAssertExtensions(t, document)
+}
+
+func doTestExtensions(t *testing.T, filename string) {
+	ctx, err := GetContext(filename)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	document, err := ctx.GetDocument()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doAssertExtensions(t, document)
+}
+
+func doAssertExtensions(t *testing.T, document *project.Specification) {
+	assert.Equal(t, project.Extensions{"x-vendor": "raitonbl"}, document.Extensions)
+	assert.Equal(t, project.Extensions{"x-audience": []interface{}{"developers", "operators"}}, document.Subcommands[0].Extensions)
+	assert.Equal(t, project.Extensions{"x-position": "first"}, document.Subcommands[0].Parameters[0].Extensions)
+	assert.Nil(t, document.Subcommands[0].Exit[0].Extensions)
+	assert.Equal(t, project.Extensions{"x-completion": "file"}, document.Parameters[0].Extensions)
+	assert.Equal(t, project.Extensions{"x-format-hint": "path"}, document.Parameters[0].Schema.Extensions)
+	assert.EqualValues(t, 3, document.Exit[0].Extensions["x-severity"])
+}
+
+func TestGetContext_where_yaml_has_references(t *testing.T) {
+	ctx, err := GetContext("commands/lint/testdata/index-064.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	document, err := ctx.GetDocument()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 3, len(document.Imports))
+	assert.NotNil(t, document.Imports["shared/types.yaml"])
+
+	parameter := document.GetParameter("./shared/params.yaml#/parameters/verbose")
+	assert.Equal(t, "verbose", *parameter.Name)
+	assert.Equal(t, "./shared/params.yaml#/schemas/verbosity", *parameter.Schema.RefersTo)
+	assert.Equal(t, []string{"quiet", "normal", "debug"}, document.ResolveSchema(parameter.Schema).Enum)
+
+	parameter = document.GetParameter("./shared/params.yaml#/parameters/format")
+	assert.Equal(t, []string{"text", "json"}, document.ResolveSchema(parameter.Schema).Enum)
+
+	assert.Equal(t, 2, *document.GetExit("./shared/exit.yaml#/exit/file-not-found").Code)
+	assert.Nil(t, document.GetParameter("./shared/params.yaml#/parameters/unknown"))
+	assert.Nil(t, document.GetParameter("./shared/exit.yaml#/exit/file-not-found"))
+}
+
+func TestGetContext_where_reference_doesnt_exist(t *testing.T) {
+	ctx, err := GetContext("commands/lint/testdata/index-065.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	document, err := ctx.GetDocument()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Nil(t, document.Imports["shared/missing.yaml"])
+
+	if _, err = ctx.GetReference("./shared/missing.yaml"); err == nil {
+		t.Fatal("error not caught")
+	}
+
+}
+
+func TestSpecification_GetCommand_where_command_is_nested(t *testing.T) {
+	ctx := NewContext("index.yaml", []byte(`
+name: cli
+version: 1.0.0
+description: application
+commands:
+  - name: build
+    description: allows to build
+    commands:
+      - name: stack
+        description: allows to build the stack
+        parameters:
+          - name: output
+            description: the output file
+            schema:
+              refers-to: path
+          - refers-to: verbose
+        exit:
+          - code: 1
+            message: Stack cannot be built
+parameters:
+  - id: verbose
+    name: verbose
+    description: the verbosity
+    schema:
+      type: boolean
+schemas:
+  - id: path
+    type: string
+    pattern: "^/"
+`))
+
+	document, err := ctx.GetDocument()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	command := document.GetCommand("build", "stack")
+
+	assert.Equal(t, []string{"build", "stack"}, command.Path)
+	assert.Equal(t, "build", command.Parent.GetName())
+	assert.Equal(t, 2, len(command.Parameters))
+	assert.Equal(t, "the output file", *command.Parameters[0].Parameter.Description)
+	assert.Equal(t, "path", command.Parameters[0].SchemaRefersTo)
+	assert.Equal(t, "^/", *command.Parameters[0].Parameter.Schema.Pattern)
+	assert.Equal(t, "verbose", *command.Parameters[1].Parameter.Name)
+	assert.Equal(t, "verbose", command.Parameters[1].RefersTo)
+	assert.Equal(t, 1, len(command.Exit))
+	assert.Equal(t, "Stack cannot be built", *command.Exit[0].Exit.Message)
+	assert.Equal(t, 1, len(document.GetLeafCommands()))
+}
@@ -32,6 +32,14 @@ func (instance *ProblemFactory) GetUnsupportedDescriptor() error {
 	return &Problem{Code: 3, Message: "unsupported descriptor"}
 }
 
+func (instance *ProblemFactory) GetUnsupportedFormat(format string) error {
+	return &Problem{Code: 1, Message: fmt.Sprintf("unsupported format '%s'", format)}
+}
+
+func (instance *ProblemFactory) GetInvalidConfiguration(path string, value interface{}) error {
+	return &Problem{Code: 1, Message: fmt.Sprintf("configuration '%s' isn't valid\ncaused by:%s", path, value)}
+}
+
 func (instance *ProblemFactory) GetFileNotFound(path string) error {
 	return &Problem{Code: 1, Message: fmt.Sprintf("file '%s' cannot be found", path)}
 }
@@ -40,6 +48,10 @@ func (instance *ProblemFactory) GetFileCannotBeOpened(path string, error error)
 	return &Problem{Code: 1, Message: fmt.Sprintf("file '%s' cannot be opened\ncaused by:%s", path, error)}
 }
 
+func (instance *ProblemFactory) GetFileCannotBeWritten(path string, error error) error {
+	return &Problem{Code: 1, Message: fmt.Sprintf("file '%s' cannot be written\ncaused by:%s", path, error)}
+}
+
 func (instance *ProblemFactory) GetUnexpectedState() error {
 	return &Problem{Code: 1, Message: "unexpected application state"}
 }
@@ -52,6 +64,10 @@ func (instance *ProblemFactory) GetMissingParameter(name string) error {
 	return &Problem{Code: 101, Message: fmt.Sprintf("missing parameters[\"id\":\"%s\"]", name)}
 }
 
+func (instance *ProblemFactory) GetUnresolvableReference(value string) error {
+	return &Problem{Code: 101, Message: fmt.Sprintf("refers-to \"%s\" cannot be resolved", value)}
+}
+
 type Problem struct {
 	Code    int
 	Message string
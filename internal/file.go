@@ -1,7 +1,10 @@
 package internal
 
 import (
+	"gopkg.in/yaml.v3"
 	"os"
+	"strconv"
+	"strings"
 )
 
 func GetFile(path string) (*File, error) {
@@ -28,6 +31,12 @@ func GetFile(path string) (*File, error) {
 type File struct {
 	path    string
 	content []byte
+	node    *yaml.Node
+}
+
+type Position struct {
+	Line   int
+	Column int
 }
 
 func (instance *File) GetName() string {
@@ -37,3 +46,59 @@ func (instance *File) GetName() string {
 func (instance *File) GetContent() []byte {
 	return instance.content
 }
+
+// GetPosition resolves the JSON pointer against the content, returning the position of the deepest node
+// which can be resolved, since a violation might refer to a field which isn't defined (e.g. a required field)
+func (instance *File) GetPosition(pointer string) *Position {
+
+	if instance.node == nil {
+		node := &yaml.Node{}
+
+		if err := yaml.Unmarshal(instance.content, node); err != nil {
+			return nil
+		}
+
+		instance.node = node
+	}
+
+	if instance.node.Kind != yaml.DocumentNode || len(instance.node.Content) == 0 {
+		return nil
+	}
+
+	node := instance.node.Content[0]
+	position := &Position{Line: node.Line, Column: node.Column}
+
+	for _, segment := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		key, value := getChild(node, strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~"))
+
+		if value == nil {
+			break
+		}
+
+		node = value
+		position = &Position{Line: key.Line, Column: key.Column}
+	}
+
+	return position
+}
+
+func getChild(node *yaml.Node, segment string) (*yaml.Node, *yaml.Node) {
+
+	if node.Kind == yaml.MappingNode {
+		for index := 0; index+1 < len(node.Content); index += 2 {
+			if node.Content[index].Value == segment {
+				return node.Content[index], node.Content[index+1]
+			}
+		}
+	}
+
+	if node.Kind == yaml.SequenceNode {
+		index, err := strconv.Atoi(segment)
+
+		if err == nil && index >= 0 && index < len(node.Content) {
+			return node.Content[index], node.Content[index]
+		}
+	}
+
+	return nil, nil
+}
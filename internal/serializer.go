@@ -0,0 +1,25 @@
+package internal
+
+import (
+	"encoding/json"
+	"github.com/raitonbl/ant/pkg/project"
+	"gopkg.in/yaml.v3"
+	"strings"
+)
+
+func Serialize(document *project.Specification, filename string) ([]byte, error) {
+
+	if document == nil {
+		return nil, GetProblemFactory().GetUnexpectedContext()
+	}
+
+	if strings.HasSuffix(filename, ".json") {
+		return json.MarshalIndent(document, "", "  ")
+	}
+
+	if strings.HasSuffix(filename, ".yaml") || strings.HasSuffix(filename, ".yml") {
+		return yaml.Marshal(document)
+	}
+
+	return nil, GetProblemFactory().GetUnsupportedDescriptor()
+}
@@ -7,3 +7,12 @@ import (
 func IsBlank(value string) bool {
 	return len(strings.TrimSpace(value)) == 0
 }
+
+func GetText(value *string) string {
+
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
@@ -38,6 +38,10 @@ func main() {
 
 	cmd.AddLintCommand(registry)
 	cmd.AddExportCommand(registry)
+	cmd.AddGenerateCommand(registry)
+	cmd.AddBundleCommand(registry)
+	cmd.AddDiffCommand(registry)
+	cmd.AddChangelogCommand(registry)
 
 	registry.Parse(nil)
 }
@@ -0,0 +1,251 @@
+// Package converter turns the raw values given through the command line into the Go value of their schema.
+//
+// The Go type of each schema is:
+//
+//	boolean                   bool
+//	number (int32)            int32
+//	number (int64)            int64
+//	number (float, double)    float64
+//	string (date, datetime)   time.Time
+//	string (byte)             []byte, decoded from base64
+//	string (binary)           []byte
+//	string                    string
+//	array                     a slice of the Go type of its items (e.g. []int32)
+package converter
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/raitonbl/ant/pkg/spec"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+const (
+	DateLayout     = "2006-01-02"
+	DateTimeLayout = time.RFC3339
+)
+
+// Error describes why a value cannot be converted
+type Error struct {
+	Value  string
+	Type   spec.SchemaType
+	Format spec.SchemaFormat
+	// Index is the position of the value within the values of an array, otherwise -1
+	Index  int
+	Reason string
+}
+
+func (instance *Error) Error() string {
+	target := string(instance.Type)
+
+	if instance.Format != "" {
+		target = string(instance.Format)
+	}
+
+	if instance.Index >= 0 {
+		return fmt.Sprintf("cannot convert item %d '%s' into %s: %s", instance.Index, instance.Value, target, instance.Reason)
+	}
+
+	return fmt.Sprintf("cannot convert '%s' into %s: %s", instance.Value, target, instance.Reason)
+}
+
+// Convert turns the value into the Go value of the schema, where a schema without type is a string
+func Convert(schema *spec.Schema, value string) (interface{}, error) {
+	typeOf, formatOf := getType(schema), getFormat(schema)
+
+	if typeOf == spec.Array {
+		return ConvertAll(schema, []string{value})
+	}
+
+	object, reason := doConvert(typeOf, formatOf, value)
+
+	if reason != "" {
+		return nil, &Error{Value: value, Type: typeOf, Format: formatOf, Index: -1, Reason: reason}
+	}
+
+	return object, nil
+}
+
+// ConvertAll turns the values into a slice of the Go value of the items of the array schema (e.g. []int32). A schema
+// which isn't an array is treated as the schema of its items
+func ConvertAll(schema *spec.Schema, values []string) (interface{}, error) {
+	items := schema
+
+	if getType(schema) == spec.Array {
+		items = schema.Items
+	}
+
+	typeOf, formatOf := getType(items), getFormat(items)
+	array := reflect.MakeSlice(reflect.SliceOf(getGoType(typeOf, formatOf)), 0, len(values))
+
+	for index, value := range values {
+		object, reason := doConvert(typeOf, formatOf, value)
+
+		if reason != "" {
+			return nil, &Error{Value: value, Type: typeOf, Format: formatOf, Index: index, Reason: reason}
+		}
+
+		array = reflect.Append(array, reflect.ValueOf(object))
+	}
+
+	return array.Interface(), nil
+}
+
+func doConvert(typeOf spec.SchemaType, formatOf spec.SchemaFormat, value string) (interface{}, string) {
+
+	switch typeOf {
+	case spec.Bool:
+		return toBool(value)
+	case spec.Number:
+		return toNumber(formatOf, value)
+	case spec.Array:
+		return nil, "arrays of arrays aren't supported"
+	}
+
+	return toString(formatOf, value)
+}
+
+func toBool(value string) (interface{}, string) {
+	object, err := strconv.ParseBool(value)
+
+	if err != nil {
+		return nil, "must be true or false"
+	}
+
+	return object, ""
+}
+
+func toNumber(formatOf spec.SchemaFormat, value string) (interface{}, string) {
+
+	switch formatOf {
+	case spec.Int32:
+		object, err := strconv.ParseInt(value, 10, 32)
+
+		if err != nil {
+			return nil, getNumberReason(err, formatOf)
+		}
+
+		return int32(object), ""
+	case spec.Int64:
+		object, err := strconv.ParseInt(value, 10, 64)
+
+		if err != nil {
+			return nil, getNumberReason(err, formatOf)
+		}
+
+		return object, ""
+	case spec.Float:
+		object, err := strconv.ParseFloat(value, 32)
+
+		if err != nil {
+			return nil, getNumberReason(err, formatOf)
+		}
+
+		return object, ""
+	}
+
+	object, err := strconv.ParseFloat(value, 64)
+
+	if err != nil {
+		return nil, getNumberReason(err, spec.Double)
+	}
+
+	return object, ""
+}
+
+func getNumberReason(err error, formatOf spec.SchemaFormat) string {
+
+	if errors.Is(err, strconv.ErrRange) {
+		return fmt.Sprintf("must be within the range of %s", formatOf)
+	}
+
+	if formatOf == spec.Int32 || formatOf == spec.Int64 {
+		return "must be an integer"
+	}
+
+	return "must be a number"
+}
+
+func toString(formatOf spec.SchemaFormat, value string) (interface{}, string) {
+
+	switch formatOf {
+	case spec.Date:
+		object, err := time.Parse(DateLayout, value)
+
+		if err != nil {
+			return nil, "must be a date (yyyy-mm-dd)"
+		}
+
+		return object, ""
+	case spec.DateTime:
+		object, err := time.Parse(DateTimeLayout, value)
+
+		if err != nil {
+			return nil, "must be a date-time (RFC 3339)"
+		}
+
+		return object, ""
+	case spec.Byte:
+		object, err := base64.StdEncoding.DecodeString(value)
+
+		if err != nil {
+			return nil, "must be base64 encoded"
+		}
+
+		return object, ""
+	case spec.Binary:
+		return []byte(value), ""
+	}
+
+	return value, ""
+}
+
+func getGoType(typeOf spec.SchemaType, formatOf spec.SchemaFormat) reflect.Type {
+
+	switch typeOf {
+	case spec.Bool:
+		return reflect.TypeOf(false)
+	case spec.Number:
+		if formatOf == spec.Int32 {
+			return reflect.TypeOf(int32(0))
+		}
+
+		if formatOf == spec.Int64 {
+			return reflect.TypeOf(int64(0))
+		}
+
+		return reflect.TypeOf(float64(0))
+	case spec.Array:
+		return reflect.TypeOf((*interface{})(nil)).Elem()
+	}
+
+	switch formatOf {
+	case spec.Date, spec.DateTime:
+		return reflect.TypeOf(time.Time{})
+	case spec.Byte, spec.Binary:
+		return reflect.TypeOf([]byte{})
+	}
+
+	return reflect.TypeOf("")
+}
+
+func getType(schema *spec.Schema) spec.SchemaType {
+
+	if schema == nil || schema.TypeOf == nil {
+		return spec.String
+	}
+
+	return *schema.TypeOf
+}
+
+func getFormat(schema *spec.Schema) spec.SchemaFormat {
+
+	if schema == nil || schema.Format == nil {
+		return ""
+	}
+
+	return *schema.Format
+}
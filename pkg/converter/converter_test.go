@@ -0,0 +1,103 @@
+package converter
+
+import (
+	"github.com/raitonbl/ant/pkg/spec"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestConvert(t *testing.T) {
+	doConvertTest(t, getSchema(spec.Number, spec.Int32), "-12", int32(-12))
+	doConvertTest(t, getSchema(spec.Number, spec.Int64), "3000000000", int64(3000000000))
+	doConvertTest(t, getSchema(spec.Number, spec.Float), "1.5", 1.5)
+	doConvertTest(t, getSchema(spec.Number, ""), "2.25", 2.25)
+	doConvertTest(t, getSchema(spec.Bool, ""), "true", true)
+	doConvertTest(t, getSchema(spec.String, spec.Date), "2022-03-01", time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC))
+	doConvertTest(t, getSchema(spec.String, spec.DateTime), "2022-03-01T10:00:00Z", time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC))
+	doConvertTest(t, getSchema(spec.String, spec.Byte), "YW50", []byte("ant"))
+	doConvertTest(t, getSchema(spec.String, spec.Binary), "ant", []byte("ant"))
+	doConvertTest(t, getSchema(spec.String, ""), "ant", "ant")
+	doConvertTest(t, nil, "ant", "ant")
+}
+
+func TestConvert_where_value_is_invalid(t *testing.T) {
+	doConvertErrorTest(t, getSchema(spec.Number, spec.Int32), "3000000000", "cannot convert '3000000000' into int32: must be within the range of int32")
+	doConvertErrorTest(t, getSchema(spec.Number, spec.Int64), "1.5", "cannot convert '1.5' into int64: must be an integer")
+	doConvertErrorTest(t, getSchema(spec.Number, ""), "one", "cannot convert 'one' into number: must be a number")
+	doConvertErrorTest(t, getSchema(spec.Bool, ""), "yes", "cannot convert 'yes' into boolean: must be true or false")
+	doConvertErrorTest(t, getSchema(spec.String, spec.Date), "2022-13-01", "cannot convert '2022-13-01' into date: must be a date (yyyy-mm-dd)")
+	doConvertErrorTest(t, getSchema(spec.String, spec.Byte), "a!", "cannot convert 'a!' into byte: must be base64 encoded")
+}
+
+func TestConvertAll(t *testing.T) {
+	schema := getSchema(spec.Array, "")
+	schema.Items = getSchema(spec.Number, spec.Int32)
+
+	value, err := ConvertAll(schema, []string{"1", "2"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []int32{1, 2}, value)
+
+	schema.Items = getSchema(spec.String, spec.Date)
+
+	value, err = ConvertAll(schema, []string{"2022-03-01"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []time.Time{time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)}, value)
+
+	value, err = ConvertAll(&spec.Schema{TypeOf: schema.TypeOf}, []string{"a", "b"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []string{"a", "b"}, value)
+}
+
+func TestConvertAll_where_item_is_invalid(t *testing.T) {
+	schema := getSchema(spec.Array, "")
+	schema.Items = getSchema(spec.Number, spec.Int64)
+
+	_, err := ConvertAll(schema, []string{"1", "two"})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, err.(*Error).Index)
+	assert.Equal(t, "cannot convert item 1 'two' into int64: must be an integer", err.Error())
+}
+
+func doConvertTest(t *testing.T, schema *spec.Schema, value string, expected interface{}) {
+	object, err := Convert(schema, value)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, expected, object)
+}
+
+func doConvertErrorTest(t *testing.T, schema *spec.Schema, value string, message string) {
+	_, err := Convert(schema, value)
+
+	if err == nil {
+		t.Fatalf("'%s' is expected to be invalid", value)
+	}
+
+	assert.Equal(t, message, err.Error())
+}
+
+func getSchema(typeOf spec.SchemaType, formatOf spec.SchemaFormat) *spec.Schema {
+	schema := &spec.Schema{TypeOf: &typeOf}
+
+	if formatOf != "" {
+		schema.Format = &formatOf
+	}
+
+	return schema
+}
@@ -0,0 +1,73 @@
+package project
+
+import "gopkg.in/yaml.v3"
+
+type Command struct {
+	Id          *string      `yaml:"id,omitempty" json:"id,omitempty"`
+	Name        *string      `yaml:"name,omitempty" json:"name,omitempty"`
+	Aliases     []string     `yaml:"aliases,omitempty" json:"aliases,omitempty"`
+	Hidden      *bool        `yaml:"hidden,omitempty" json:"hidden,omitempty"`
+	Description *string      `yaml:"description,omitempty" json:"description,omitempty"`
+	Subcommands []*Command   `yaml:"commands,omitempty" json:"commands,omitempty"`
+	Parameters  []Parameter  `yaml:"parameters,omitempty" json:"parameters,omitempty"`
+	Exit        []Exit       `yaml:"exit,omitempty" json:"exit,omitempty"`
+	Constraints *Constraints `yaml:"constraints,omitempty" json:"constraints,omitempty"`
+	Deprecated  *Deprecation `yaml:"deprecated,omitempty" json:"deprecated,omitempty"`
+	Extensions  Extensions   `yaml:"-" json:"-"`
+}
+
+type command Command
+
+func (instance *Command) UnmarshalJSON(binary []byte) error {
+	extensions, err := unmarshalJson(binary, (*command)(instance))
+	instance.Extensions = extensions
+	return err
+}
+
+func (instance *Command) UnmarshalYAML(node *yaml.Node) error {
+	extensions, err := unmarshalYaml(node, (*command)(instance))
+	instance.Extensions = extensions
+	return err
+}
+
+func (instance Command) MarshalJSON() ([]byte, error) {
+	return marshalJson(command(instance), instance.Extensions)
+}
+
+func (instance Command) MarshalYAML() (interface{}, error) {
+	return marshalYaml(command(instance), instance.Extensions)
+}
+
+func (instance Command) GetName() string {
+
+	if instance.Name == nil {
+		return ""
+	}
+
+	return *instance.Name
+}
+
+// IsNamed determines whether the command is invoked through the value, which is either its name or one of its aliases
+func (instance Command) IsNamed(value string) bool {
+
+	if instance.Name != nil && *instance.Name == value {
+		return true
+	}
+
+	for _, each := range instance.Aliases {
+		if each == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsHidden determines whether the command is left out of the completion scripts and documents, even though it can be invoked
+func (instance Command) IsHidden() bool {
+	return instance.Hidden != nil && *instance.Hidden
+}
+
+func (instance Command) IsDeprecated() bool {
+	return instance.Deprecated.IsDeprecated()
+}
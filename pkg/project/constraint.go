@@ -0,0 +1,36 @@
+package project
+
+import "gopkg.in/yaml.v3"
+
+// Constraints relate the parameters of a command, where each group references parameters by name or id
+type Constraints struct {
+	// Exclusive groups hold parameters which cannot be given together
+	Exclusive [][]string `yaml:"exclusive,omitempty" json:"exclusive,omitempty"`
+	// RequiredTogether groups hold parameters which must be given together, once any of them is given
+	RequiredTogether [][]string `yaml:"required-together,omitempty" json:"required-together,omitempty"`
+	// AtLeastOneOf groups hold parameters from which at least one must be given
+	AtLeastOneOf [][]string `yaml:"at-least-one-of,omitempty" json:"at-least-one-of,omitempty"`
+	Extensions   Extensions `yaml:"-" json:"-"`
+}
+
+type constraints Constraints
+
+func (instance *Constraints) UnmarshalJSON(binary []byte) error {
+	extensions, err := unmarshalJson(binary, (*constraints)(instance))
+	instance.Extensions = extensions
+	return err
+}
+
+func (instance *Constraints) UnmarshalYAML(node *yaml.Node) error {
+	extensions, err := unmarshalYaml(node, (*constraints)(instance))
+	instance.Extensions = extensions
+	return err
+}
+
+func (instance Constraints) MarshalJSON() ([]byte, error) {
+	return marshalJson(constraints(instance), instance.Extensions)
+}
+
+func (instance Constraints) MarshalYAML() (interface{}, error) {
+	return marshalYaml(constraints(instance), instance.Extensions)
+}
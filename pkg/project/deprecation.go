@@ -0,0 +1,84 @@
+package project
+
+import (
+	"encoding/json"
+	"gopkg.in/yaml.v3"
+)
+
+// Deprecation tells whether a command, parameter or exit is deprecated, which the document declares either as a boolean or
+// as an object with since, replaced-by and message (meaning it is deprecated)
+type Deprecation struct {
+	Deprecated bool    `yaml:"-" json:"-"`
+	Since      *string `yaml:"since,omitempty" json:"since,omitempty"`
+	ReplacedBy *string `yaml:"replaced-by,omitempty" json:"replaced-by,omitempty"`
+	Message    *string `yaml:"message,omitempty" json:"message,omitempty"`
+}
+
+type deprecation Deprecation
+
+func (instance *Deprecation) UnmarshalJSON(binary []byte) error {
+	var value bool
+
+	if err := json.Unmarshal(binary, &value); err == nil {
+		*instance = Deprecation{Deprecated: value}
+		return nil
+	}
+
+	if err := json.Unmarshal(binary, (*deprecation)(instance)); err != nil {
+		return err
+	}
+
+	instance.Deprecated = true
+
+	return nil
+}
+
+func (instance *Deprecation) UnmarshalYAML(node *yaml.Node) error {
+
+	if node.Kind == yaml.ScalarNode {
+		var value bool
+
+		if err := node.Decode(&value); err != nil {
+			return err
+		}
+
+		*instance = Deprecation{Deprecated: value}
+
+		return nil
+	}
+
+	if err := node.Decode((*deprecation)(instance)); err != nil {
+		return err
+	}
+
+	instance.Deprecated = true
+
+	return nil
+}
+
+func (instance Deprecation) MarshalJSON() ([]byte, error) {
+
+	if instance.isBoolean() {
+		return json.Marshal(instance.Deprecated)
+	}
+
+	return json.Marshal(deprecation(instance))
+}
+
+func (instance Deprecation) MarshalYAML() (interface{}, error) {
+
+	if instance.isBoolean() {
+		return instance.Deprecated, nil
+	}
+
+	return deprecation(instance), nil
+}
+
+// IsDeprecated determines whether the deprecation applies, which it doesn't when it is nil or false
+func (instance *Deprecation) IsDeprecated() bool {
+	return instance != nil && instance.Deprecated
+}
+
+func (instance Deprecation) isBoolean() bool {
+	return !instance.Deprecated || (instance.Since == nil && instance.ReplacedBy == nil && instance.Message == nil)
+}
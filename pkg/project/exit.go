@@ -0,0 +1,39 @@
+package project
+
+import "gopkg.in/yaml.v3"
+
+type Exit struct {
+	Code        *int         `yaml:"code,omitempty" json:"code,omitempty"`
+	Message     *string      `yaml:"message,omitempty" json:"message,omitempty"`
+	Id          *string      `yaml:"id,omitempty" json:"id,omitempty"`
+	RefersTo    *string      `yaml:"refers-to,omitempty" json:"refers-to,omitempty"`
+	Description *string      `yaml:"description,omitempty" json:"description,omitempty"`
+	Deprecated  *Deprecation `yaml:"deprecated,omitempty" json:"deprecated,omitempty"`
+	Extensions  Extensions   `yaml:"-" json:"-"`
+}
+
+type exit Exit
+
+func (instance *Exit) UnmarshalJSON(binary []byte) error {
+	extensions, err := unmarshalJson(binary, (*exit)(instance))
+	instance.Extensions = extensions
+	return err
+}
+
+func (instance *Exit) UnmarshalYAML(node *yaml.Node) error {
+	extensions, err := unmarshalYaml(node, (*exit)(instance))
+	instance.Extensions = extensions
+	return err
+}
+
+func (instance Exit) MarshalJSON() ([]byte, error) {
+	return marshalJson(exit(instance), instance.Extensions)
+}
+
+func (instance Exit) MarshalYAML() (interface{}, error) {
+	return marshalYaml(exit(instance), instance.Extensions)
+}
+
+func (instance Exit) IsDeprecated() bool {
+	return instance.Deprecated.IsDeprecated()
+}
@@ -0,0 +1,143 @@
+package project
+
+import (
+	"bytes"
+	"encoding/json"
+	"gopkg.in/yaml.v3"
+	"sort"
+	"strings"
+)
+
+const extension_prefix = "x-"
+
+type Extensions map[string]interface{}
+
+func IsExtension(key string) bool {
+	return strings.HasPrefix(key, extension_prefix)
+}
+
+func unmarshalJson(binary []byte, value interface{}) (Extensions, error) {
+
+	if err := json.Unmarshal(binary, value); err != nil {
+		return nil, err
+	}
+
+	object := make(map[string]interface{})
+
+	if err := json.Unmarshal(binary, &object); err != nil {
+		return nil, err
+	}
+
+	var extensions Extensions
+
+	for key, each := range object {
+		if IsExtension(key) {
+			if extensions == nil {
+				extensions = make(Extensions)
+			}
+			extensions[key] = each
+		}
+	}
+
+	return extensions, nil
+}
+
+func unmarshalYaml(node *yaml.Node, value interface{}) (Extensions, error) {
+
+	if err := node.Decode(value); err != nil {
+		return nil, err
+	}
+
+	var extensions Extensions
+
+	for index := 0; node.Kind == yaml.MappingNode && index+1 < len(node.Content); index += 2 {
+		key := node.Content[index].Value
+
+		if !IsExtension(key) {
+			continue
+		}
+
+		var each interface{}
+
+		if err := node.Content[index+1].Decode(&each); err != nil {
+			return nil, err
+		}
+
+		if extensions == nil {
+			extensions = make(Extensions)
+		}
+
+		extensions[key] = each
+	}
+
+	return extensions, nil
+}
+
+func marshalJson(value interface{}, extensions Extensions) ([]byte, error) {
+	binary, err := json.Marshal(value)
+
+	if err != nil || len(extensions) == 0 {
+		return binary, err
+	}
+
+	buffer := bytes.NewBuffer(bytes.TrimSuffix(bytes.TrimSpace(binary), []byte("}")))
+
+	for index, key := range extensions.keys() {
+		each, err := json.Marshal(extensions[key])
+
+		if err != nil {
+			return nil, err
+		}
+
+		name, _ := json.Marshal(key)
+
+		if index > 0 || buffer.Len() > 1 {
+			buffer.WriteByte(',')
+		}
+
+		buffer.Write(name)
+		buffer.WriteByte(':')
+		buffer.Write(each)
+	}
+
+	buffer.WriteByte('}')
+
+	return buffer.Bytes(), nil
+}
+
+func marshalYaml(value interface{}, extensions Extensions) (interface{}, error) {
+
+	if len(extensions) == 0 {
+		return value, nil
+	}
+
+	node := &yaml.Node{}
+
+	if err := node.Encode(value); err != nil {
+		return nil, err
+	}
+
+	for _, key := range extensions.keys() {
+		each := &yaml.Node{}
+
+		if err := each.Encode(extensions[key]); err != nil {
+			return nil, err
+		}
+
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, each)
+	}
+
+	return node, nil
+}
+
+func (instance Extensions) keys() []string {
+	array := make([]string, 0, len(instance))
+
+	for key := range instance {
+		array = append(array, key)
+	}
+
+	sort.Strings(array)
+
+	return array
+}
@@ -0,0 +1,148 @@
+package project
+
+import "gopkg.in/yaml.v3"
+
+type In string
+
+const (
+	Flags     In = "flags"
+	Arguments In = "arguments"
+)
+
+type Parameter struct {
+	Id           *string      `yaml:"id,omitempty" json:"id,omitempty"`
+	In           *In          `yaml:"in,omitempty" json:"in,omitempty"`
+	Index        *int         `yaml:"index,omitempty" json:"index,omitempty"`
+	Required     *bool        `yaml:"required,omitempty" json:"required,omitempty"`
+	Name         *string      `yaml:"name,omitempty" json:"name,omitempty"`
+	ShortForm    *string      `yaml:"short-form,omitempty" json:"short-form,omitempty"`
+	Description  *string      `yaml:"description,omitempty" json:"description,omitempty"`
+	RefersTo     *string      `yaml:"refers-to,omitempty" json:"refers-to,omitempty"`
+	DefaultValue *string      `yaml:"default,omitempty" json:"default,omitempty"`
+	Env          *string      `yaml:"env,omitempty" json:"env,omitempty"`
+	Schema       *Schema      `yaml:"schema,omitempty" json:"schema,omitempty"`
+	Deprecated   *Deprecation `yaml:"deprecated,omitempty" json:"deprecated,omitempty"`
+	Extensions   Extensions   `yaml:"-" json:"-"`
+}
+
+type parameter Parameter
+
+func (instance *Parameter) UnmarshalJSON(binary []byte) error {
+	extensions, err := unmarshalJson(binary, (*parameter)(instance))
+	instance.Extensions = extensions
+	return err
+}
+
+func (instance *Parameter) UnmarshalYAML(node *yaml.Node) error {
+	extensions, err := unmarshalYaml(node, (*parameter)(instance))
+	instance.Extensions = extensions
+	return err
+}
+
+func (instance Parameter) MarshalJSON() ([]byte, error) {
+	return marshalJson(parameter(instance), instance.Extensions)
+}
+
+func (instance Parameter) MarshalYAML() (interface{}, error) {
+	return marshalYaml(parameter(instance), instance.Extensions)
+}
+
+func (instance Parameter) Clone() *Parameter {
+	object := Parameter{}
+
+	if instance.Id != nil {
+		object.Id = instance.Id
+	}
+
+	if instance.In != nil {
+		object.In = instance.In
+	}
+
+	if instance.Index != nil {
+		object.Index = instance.Index
+	}
+
+	if instance.Required != nil {
+		object.Required = instance.Required
+	}
+
+	if instance.Name != nil {
+		object.Name = instance.Name
+	}
+
+	if instance.ShortForm != nil {
+		object.ShortForm = instance.ShortForm
+	}
+
+	if instance.Description != nil {
+		object.Description = instance.Description
+	}
+
+	if instance.RefersTo != nil {
+		object.RefersTo = instance.RefersTo
+	}
+
+	if instance.DefaultValue != nil {
+		object.DefaultValue = instance.DefaultValue
+	}
+
+	if instance.Env != nil {
+		object.Env = instance.Env
+	}
+
+	if instance.Schema != nil {
+		object.Schema = instance.Schema
+	}
+
+	if instance.Deprecated != nil {
+		object.Deprecated = instance.Deprecated
+	}
+
+	if instance.Extensions != nil {
+		object.Extensions = instance.Extensions
+	}
+
+	return &object
+}
+
+func (instance Parameter) GetName() string {
+
+	if instance.Name == nil {
+		return ""
+	}
+
+	return *instance.Name
+}
+
+func (instance Parameter) GetIndex() int {
+
+	if instance.Index == nil {
+		return 0
+	}
+
+	return *instance.Index
+}
+
+// IsArgument determines whether the parameter is in arguments, since a parameter is in flags by default
+func (instance Parameter) IsArgument() bool {
+	return instance.In != nil && *instance.In == Arguments
+}
+
+// IsVariadic determines whether the parameter is an argument of type array, which binds every remaining positional value
+func (instance Parameter) IsVariadic() bool {
+	return instance.IsArgument() && instance.Schema != nil && instance.Schema.TypeOf != nil && *instance.Schema.TypeOf == Array
+}
+
+// IsRequired determines whether the parameter must be given, which an argument without default is by default
+func (instance Parameter) IsRequired() bool {
+
+	if instance.Required != nil {
+		return *instance.Required
+	}
+
+	return instance.IsArgument() && instance.DefaultValue == nil
+}
+
+func (instance Parameter) IsDeprecated() bool {
+	return instance.Deprecated.IsDeprecated()
+}
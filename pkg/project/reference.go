@@ -0,0 +1,184 @@
+package project
+
+import (
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	ParametersSection = "parameters"
+	ExitSection       = "exit"
+	SchemasSection    = "schemas"
+)
+
+const reference_separator = "#"
+
+// Reference represents the value of refers-to, which is either an id within the document or a
+// pointer into another file such as ./shared/params.yaml#/parameters/verbose
+type Reference struct {
+	Filename string
+	Section  string
+	Id       string
+}
+
+func IsExternalReference(value string) bool {
+	return strings.Contains(value, reference_separator)
+}
+
+func GetReference(value string) *Reference {
+
+	if !IsExternalReference(value) {
+		return &Reference{Id: value}
+	}
+
+	index := strings.Index(value, reference_separator)
+	filename, pointer := value[:index], value[index+1:]
+	segments := strings.Split(pointer, "/")
+
+	if filename == "" || len(segments) != 3 || segments[0] != "" || segments[2] == "" {
+		return nil
+	}
+
+	if segments[1] != ParametersSection && segments[1] != ExitSection && segments[1] != SchemasSection {
+		return nil
+	}
+
+	return &Reference{Filename: filename, Section: segments[1], Id: segments[2]}
+}
+
+func (instance Reference) IsExternal() bool {
+	return instance.Filename != ""
+}
+
+func (instance Reference) String() string {
+
+	if !instance.IsExternal() {
+		return instance.Id
+	}
+
+	return instance.Filename + reference_separator + "/" + instance.Section + "/" + instance.Id
+}
+
+// qualifyParameter copies the parameter, rewriting its references so that they resolve from the referencing document
+func (instance Reference) qualifyParameter(parameter *Parameter) *Parameter {
+
+	if parameter == nil {
+		return nil
+	}
+
+	object := parameter.Clone()
+	object.Schema = instance.qualifySchema(parameter.Schema)
+
+	return object
+}
+
+func (instance Reference) qualifySchema(schema *Schema) *Schema {
+
+	if schema == nil {
+		return nil
+	}
+
+	object := *schema
+	object.RefersTo = instance.qualify(schema.RefersTo, SchemasSection)
+	object.Items = instance.qualifySchema(schema.Items)
+
+	return &object
+}
+
+func (instance Reference) qualify(value *string, section string) *string {
+
+	if value == nil {
+		return nil
+	}
+
+	reference := GetReference(*value)
+
+	if reference == nil {
+		return value
+	}
+
+	if reference.IsExternal() {
+		reference.Filename = path.Join(path.Dir(instance.Filename), reference.Filename)
+	} else {
+		reference.Filename = instance.Filename
+		reference.Section = section
+	}
+
+	text := reference.String()
+
+	return &text
+}
+
+// GetReferencedFiles returns every file which is referenced by the document, through refers-to
+func (instance Specification) GetReferencedFiles() []string {
+	cache := make(map[string]bool)
+
+	for _, each := range instance.Parameters {
+		doAddReferencedFile(cache, each.RefersTo)
+		doAddReferencedSchemaFile(cache, each.Schema)
+	}
+
+	for _, each := range instance.Exit {
+		doAddReferencedFile(cache, each.RefersTo)
+	}
+
+	for _, each := range instance.Schemas {
+		doAddReferencedSchemaFile(cache, each)
+	}
+
+	for index := range instance.Subcommands {
+		doAddReferencedCommandFile(cache, &instance.Subcommands[index])
+	}
+
+	array := make([]string, 0, len(cache))
+
+	for filename := range cache {
+		array = append(array, filename)
+	}
+
+	sort.Strings(array)
+
+	return array
+}
+
+func doAddReferencedCommandFile(cache map[string]bool, command *Command) {
+
+	if command == nil {
+		return
+	}
+
+	for _, each := range command.Parameters {
+		doAddReferencedFile(cache, each.RefersTo)
+		doAddReferencedSchemaFile(cache, each.Schema)
+	}
+
+	for _, each := range command.Exit {
+		doAddReferencedFile(cache, each.RefersTo)
+	}
+
+	for _, each := range command.Subcommands {
+		doAddReferencedCommandFile(cache, each)
+	}
+}
+
+func doAddReferencedSchemaFile(cache map[string]bool, schema *Schema) {
+
+	if schema == nil {
+		return
+	}
+
+	doAddReferencedFile(cache, schema.RefersTo)
+	doAddReferencedSchemaFile(cache, schema.Items)
+}
+
+func doAddReferencedFile(cache map[string]bool, value *string) {
+
+	if value == nil {
+		return
+	}
+
+	if reference := GetReference(*value); reference != nil && reference.IsExternal() {
+		cache[reference.Filename] = true
+	}
+}
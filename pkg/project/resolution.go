@@ -0,0 +1,261 @@
+package project
+
+import "sort"
+
+// ResolvedCommand is a command where every refers-to is followed
+type ResolvedCommand struct {
+	Path        []string
+	Command     *Command
+	Parent      *ResolvedCommand
+	Parameters  []*ResolvedParameter
+	Exit        []*ResolvedExit
+	Subcommands []*ResolvedCommand
+}
+
+// ResolvedParameter is a concrete parameter, which schema is also concrete
+type ResolvedParameter struct {
+	Parameter *Parameter
+	// RefersTo is the reference which was followed, if any
+	RefersTo string
+	// SchemaRefersTo is the reference which was followed to resolve the schema, if any
+	SchemaRefersTo string
+}
+
+type ResolvedExit struct {
+	Exit *Exit
+	// RefersTo is the reference which was followed, if any
+	RefersTo string
+}
+
+// GetCommands resolves the command tree of the document
+func (instance Specification) GetCommands() []*ResolvedCommand {
+	array := make([]*ResolvedCommand, 0)
+
+	for index := range instance.Subcommands {
+		array = append(array, instance.doResolveCommand(nil, &instance.Subcommands[index]))
+	}
+
+	return array
+}
+
+// GetCommand resolves the command which is invoked through the path (e.g. build stack)
+func (instance Specification) GetCommand(path ...string) *ResolvedCommand {
+	array := instance.GetCommands()
+	var command *ResolvedCommand
+
+	for _, name := range path {
+		command = nil
+
+		for _, each := range array {
+			if each.GetName() == name {
+				command = each
+				break
+			}
+		}
+
+		if command == nil {
+			return nil
+		}
+
+		array = command.Subcommands
+	}
+
+	return command
+}
+
+// GetLeafCommands resolves the commands which can be invoked, meaning the ones without subcommands
+func (instance Specification) GetLeafCommands() []*ResolvedCommand {
+	array := make([]*ResolvedCommand, 0)
+
+	for _, each := range instance.GetCommands() {
+		array = append(array, each.GetLeafCommands()...)
+	}
+
+	return array
+}
+
+// ResolveParameter follows the refers-to of the parameter, keeping the index it overrides. It returns nil
+// when the parameter cannot be resolved
+func (instance Specification) ResolveParameter(parameter Parameter) *ResolvedParameter {
+	object := &ResolvedParameter{Parameter: parameter.Clone()}
+
+	if parameter.RefersTo != nil {
+		fromDocument := instance.GetParameter(*parameter.RefersTo)
+
+		if fromDocument == nil {
+			return nil
+		}
+
+		object.RefersTo = *parameter.RefersTo
+		object.Parameter = fromDocument.Clone()
+
+		if parameter.Index != nil {
+			object.Parameter.Index = parameter.Index
+		}
+	}
+
+	if schema := object.Parameter.Schema; schema != nil && schema.RefersTo != nil {
+		object.SchemaRefersTo = *schema.RefersTo
+	}
+
+	object.Parameter.Schema = instance.ResolveSchema(object.Parameter.Schema)
+
+	return object
+}
+
+// ResolveExit follows the refers-to of the exit. It returns nil when the exit cannot be resolved
+func (instance Specification) ResolveExit(exit Exit) *ResolvedExit {
+
+	if exit.RefersTo == nil {
+		return &ResolvedExit{Exit: &exit}
+	}
+
+	fromDocument := instance.GetExit(*exit.RefersTo)
+
+	if fromDocument == nil {
+		return nil
+	}
+
+	return &ResolvedExit{Exit: fromDocument, RefersTo: *exit.RefersTo}
+}
+
+// ResolveSchema follows the refers-to of the schema and of its items. A schema which cannot be resolved is returned as is
+func (instance Specification) ResolveSchema(schema *Schema) *Schema {
+	return instance.doResolveSchema(schema, make([]string, 0))
+}
+
+func (instance Specification) doResolveSchema(schema *Schema, visited []string) *Schema {
+
+	if schema == nil {
+		return nil
+	}
+
+	if schema.RefersTo == nil {
+
+		if schema.Items == nil {
+			return schema
+		}
+
+		object := *schema
+		object.Items = instance.doResolveSchema(schema.Items, visited)
+
+		return &object
+	}
+
+	for _, each := range visited {
+		if each == *schema.RefersTo {
+			return schema
+		}
+	}
+
+	fromDocument := instance.GetSchema(*schema.RefersTo)
+
+	if fromDocument == nil {
+		return schema
+	}
+
+	return instance.doResolveSchema(fromDocument, append(visited, *schema.RefersTo))
+}
+
+func (instance Specification) doResolveCommand(parent *ResolvedCommand, command *Command) *ResolvedCommand {
+	object := &ResolvedCommand{Command: command, Parent: parent, Parameters: make([]*ResolvedParameter, 0), Exit: make([]*ResolvedExit, 0),
+		Subcommands: make([]*ResolvedCommand, 0)}
+
+	if parent != nil {
+		object.Path = append(object.Path, parent.Path...)
+	}
+
+	object.Path = append(object.Path, command.GetName())
+
+	for _, each := range command.Parameters {
+		if param := instance.ResolveParameter(each); param != nil {
+			object.Parameters = append(object.Parameters, param)
+		}
+	}
+
+	for _, each := range command.Exit {
+		if exit := instance.ResolveExit(each); exit != nil {
+			object.Exit = append(object.Exit, exit)
+		}
+	}
+
+	for _, each := range command.Subcommands {
+		object.Subcommands = append(object.Subcommands, instance.doResolveCommand(object, each))
+	}
+
+	return object
+}
+
+func (instance *ResolvedCommand) GetName() string {
+	return instance.Command.GetName()
+}
+
+func (instance *ResolvedCommand) IsLeaf() bool {
+	return len(instance.Subcommands) == 0
+}
+
+func (instance *ResolvedCommand) GetLeafCommands() []*ResolvedCommand {
+
+	if instance.IsLeaf() {
+		return []*ResolvedCommand{instance}
+	}
+
+	array := make([]*ResolvedCommand, 0)
+
+	for _, each := range instance.Subcommands {
+		array = append(array, each.GetLeafCommands()...)
+	}
+
+	return array
+}
+
+// GetParameters returns the concrete parameters of the command
+func (instance *ResolvedCommand) GetParameters() []*Parameter {
+	array := make([]*Parameter, 0, len(instance.Parameters))
+
+	for _, each := range instance.Parameters {
+		array = append(array, each.Parameter)
+	}
+
+	return array
+}
+
+// GetArguments returns the parameters in arguments, sorted by index
+func (instance *ResolvedCommand) GetArguments() []*ResolvedParameter {
+	array := make([]*ResolvedParameter, 0)
+
+	for _, each := range instance.Parameters {
+		if each.Parameter.IsArgument() {
+			array = append(array, each)
+		}
+	}
+
+	sort.SliceStable(array, func(i, j int) bool {
+		return array[i].Parameter.GetIndex() < array[j].Parameter.GetIndex()
+	})
+
+	return array
+}
+
+// GetFlags returns the parameters in flags, which is where a parameter is by default
+func (instance *ResolvedCommand) GetFlags() []*ResolvedParameter {
+	array := make([]*ResolvedParameter, 0)
+
+	for _, each := range instance.Parameters {
+		if !each.Parameter.IsArgument() {
+			array = append(array, each)
+		}
+	}
+
+	return array
+}
+
+// GetParameter returns the parameter which the reference names, either through its name, its id or the refers-to it is resolved from
+func (instance *ResolvedCommand) GetParameter(reference string) *ResolvedParameter {
+	for _, each := range instance.Parameters {
+		if each.Parameter.GetName() == reference || each.RefersTo == reference || (each.Parameter.Id != nil && *each.Parameter.Id == reference) {
+			return each
+		}
+	}
+	return nil
+}
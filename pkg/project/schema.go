@@ -0,0 +1,78 @@
+package project
+
+import "gopkg.in/yaml.v3"
+
+type Schema struct {
+	Id *string `yaml:"id,omitempty" json:"id,omitempty"`
+
+	// applies to number
+	MultipleOf *int `yaml:"multiple-of,omitempty" json:"multiple-of,omitempty"`
+	Maximum    *int `yaml:"maximum,omitempty" json:"maximum,omitempty"`
+	Minimum    *int `yaml:"minimum,omitempty" json:"minimum,omitempty"`
+	// applies to string
+	MaxLength *int `yaml:"max-length,omitempty" json:"max-length,omitempty"`
+	MinLength *int `yaml:"min-length,omitempty" json:"min-length,omitempty"`
+	// applies to number
+	ExclusiveMinimum *bool `yaml:"exclusive-minimum,omitempty" json:"exclusive-minimum,omitempty"`
+	ExclusiveMaximum *bool `yaml:"exclusive-maximum,omitempty" json:"exclusive-maximum,omitempty"`
+	// applies to array
+	MaxItems    *int    `yaml:"max-items,omitempty" json:"max-items,omitempty"`
+	MinItems    *int    `yaml:"min-items,omitempty" json:"min-items,omitempty"`
+	UniqueItems *bool   `yaml:"unique-items,omitempty" json:"unique-items,omitempty"`
+	Items       *Schema `yaml:"items,omitempty" json:"items,omitempty"`
+	// applies to everything
+	Enum []string `yaml:"enum,omitempty" json:"enum,omitempty"`
+	// object
+	Examples []string      `yaml:"examples,omitempty" json:"examples,omitempty"`
+	TypeOf   *SchemaType   `yaml:"type,omitempty" json:"type,omitempty"`
+	Format   *SchemaFormat `yaml:"format,omitempty" json:"format,omitempty"`
+	Pattern  *string       `yaml:"pattern,omitempty" json:"pattern,omitempty"`
+	RefersTo *string       `yaml:"refers-to,omitempty" json:"refers-to,omitempty"`
+
+	Extensions Extensions `yaml:"-" json:"-"`
+}
+
+type schema Schema
+
+func (instance *Schema) UnmarshalJSON(binary []byte) error {
+	extensions, err := unmarshalJson(binary, (*schema)(instance))
+	instance.Extensions = extensions
+	return err
+}
+
+func (instance *Schema) UnmarshalYAML(node *yaml.Node) error {
+	extensions, err := unmarshalYaml(node, (*schema)(instance))
+	instance.Extensions = extensions
+	return err
+}
+
+func (instance Schema) MarshalJSON() ([]byte, error) {
+	return marshalJson(schema(instance), instance.Extensions)
+}
+
+func (instance Schema) MarshalYAML() (interface{}, error) {
+	return marshalYaml(schema(instance), instance.Extensions)
+}
+
+type SchemaType string
+
+const (
+	String SchemaType = "string"
+	Number SchemaType = "number"
+	Bool   SchemaType = "boolean"
+	Array  SchemaType = "array"
+)
+
+type SchemaFormat string
+
+const (
+	Byte   SchemaFormat = "byte"
+	Int64  SchemaFormat = "int64"
+	Int32  SchemaFormat = "int32"
+	Float  SchemaFormat = "float"
+	Double SchemaFormat = "double"
+
+	Date     SchemaFormat = "date"
+	Binary   SchemaFormat = "binary"
+	DateTime SchemaFormat = "datetime"
+)
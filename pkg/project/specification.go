@@ -0,0 +1,125 @@
+// Package project declares the model of an ant CLI specification document, as exposed through package spec.
+package project
+
+import (
+	"gopkg.in/yaml.v3"
+	"path"
+)
+
+type Specification struct {
+	Name        *string     `yaml:"name,omitempty" json:"name,omitempty"`
+	Version     *string     `yaml:"version,omitempty" json:"version,omitempty"`
+	Subcommands []Command   `yaml:"commands,omitempty" json:"commands,omitempty"`
+	Description *string     `yaml:"description,omitempty" json:"description,omitempty"`
+	EnvPrefix   *string     `yaml:"env-prefix,omitempty" json:"env-prefix,omitempty"`
+	Parameters  []Parameter `yaml:"parameters,omitempty" json:"parameters,omitempty"`
+	Exit        []Exit      `yaml:"exit,omitempty" json:"exit,omitempty"`
+	Schemas     []*Schema   `yaml:"schemas,omitempty" json:"schemas,omitempty"`
+	Extensions  Extensions  `yaml:"-" json:"-"`
+	// Imports holds the documents referenced through refers-to, keyed by their path relative to this document
+	Imports map[string]*Specification `yaml:"-" json:"-"`
+}
+
+type specification Specification
+
+func (instance *Specification) UnmarshalJSON(binary []byte) error {
+	extensions, err := unmarshalJson(binary, (*specification)(instance))
+	instance.Extensions = extensions
+	return err
+}
+
+func (instance *Specification) UnmarshalYAML(node *yaml.Node) error {
+	extensions, err := unmarshalYaml(node, (*specification)(instance))
+	instance.Extensions = extensions
+	return err
+}
+
+func (instance Specification) MarshalJSON() ([]byte, error) {
+	return marshalJson(specification(instance), instance.Extensions)
+}
+
+func (instance Specification) MarshalYAML() (interface{}, error) {
+	return marshalYaml(specification(instance), instance.Extensions)
+}
+
+// GetEnv returns the environment variable the parameter is bound to, prefixed by the env-prefix of the document (e.g. ANT_CONFIG).
+// It returns a blank text when the parameter isn't bound to any
+func (instance Specification) GetEnv(parameter *Parameter) string {
+
+	if parameter.Env == nil {
+		return ""
+	}
+
+	if instance.EnvPrefix == nil {
+		return *parameter.Env
+	}
+
+	return *instance.EnvPrefix + *parameter.Env
+}
+
+func (instance Specification) GetParameter(id string) *Parameter {
+	if IsExternalReference(id) {
+		reference, document := instance.getImport(id, ParametersSection)
+
+		if document == nil {
+			return nil
+		}
+
+		return reference.qualifyParameter(document.GetParameter(reference.Id))
+	}
+
+	for index, each := range instance.Parameters {
+		if each.Id != nil && *each.Id == id {
+			return &instance.Parameters[index]
+		}
+	}
+	return nil
+}
+
+func (instance Specification) GetExit(id string) *Exit {
+	if IsExternalReference(id) {
+		reference, document := instance.getImport(id, ExitSection)
+
+		if document == nil {
+			return nil
+		}
+
+		return document.GetExit(reference.Id)
+	}
+
+	for index, each := range instance.Exit {
+		if each.Id != nil && *each.Id == id {
+			return &instance.Exit[index]
+		}
+	}
+	return nil
+}
+
+func (instance Specification) GetSchema(id string) *Schema {
+	if IsExternalReference(id) {
+		reference, document := instance.getImport(id, SchemasSection)
+
+		if document == nil {
+			return nil
+		}
+
+		return reference.qualifySchema(document.GetSchema(reference.Id))
+	}
+
+	for _, each := range instance.Schemas {
+		if each != nil && each.Id != nil && *each.Id == id {
+			return each
+		}
+	}
+	return nil
+}
+
+func (instance Specification) getImport(value string, section string) (*Reference, *Specification) {
+	reference := GetReference(value)
+
+	if reference == nil || !reference.IsExternal() || reference.Section != section || instance.Imports == nil {
+		return nil, nil
+	}
+
+	return reference, instance.Imports[path.Clean(reference.Filename)]
+}
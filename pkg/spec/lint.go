@@ -0,0 +1,78 @@
+package spec
+
+import (
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/internal/commands/lint"
+	"github.com/raitonbl/ant/internal/commands/lint/lint_rule"
+)
+
+type Severity string
+
+const (
+	Error   Severity = "error"
+	Warning Severity = "warning"
+	Info    Severity = "info"
+)
+
+// Violation is a rule the document doesn't comply with, located by its json pointer and, when known, its position
+type Violation struct {
+	Path     string   `json:"path"`
+	Message  string   `json:"message"`
+	RuleId   string   `json:"rule-id"`
+	Severity Severity `json:"severity"`
+	File     string   `json:"file,omitempty"`
+	Line     int      `json:"line,omitempty"`
+	Column   int      `json:"column,omitempty"`
+}
+
+// Rule is a check applied by Lint, identified by both its id and its name
+type Rule struct {
+	Id       string
+	Name     string
+	Severity Severity
+	Message  string
+}
+
+// Lint verifies the document against the specification schema and rules, including the files it refers to
+func Lint(document *Document) ([]Violation, error) {
+
+	if document == nil {
+		return nil, internal.GetProblemFactory().GetUnexpectedContext()
+	}
+
+	problems, err := lint.Lint(document.ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := make([]Violation, 0)
+
+	for _, each := range problems {
+		violations = append(violations, Violation{Path: each.Path, Message: each.Message, RuleId: each.RuleId,
+			Severity: Severity(each.Severity), File: each.File, Line: each.Line, Column: each.Column})
+	}
+
+	return violations, nil
+}
+
+// IsFailure determines whether the violations make the document invalid, which includes warnings when strict
+func IsFailure(violations []Violation, strict bool) bool {
+	for _, each := range violations {
+		if each.Severity == Error || (strict && each.Severity == Warning) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRules returns every rule applied by Lint
+func GetRules() []Rule {
+	rules := make([]Rule, 0)
+
+	for _, each := range lint_rule.GetRules() {
+		rules = append(rules, Rule{Id: each.Id, Name: each.Name, Severity: Severity(each.Severity), Message: each.Message})
+	}
+
+	return rules
+}
@@ -0,0 +1,127 @@
+// Package spec loads, resolves and lints ant CLI specification documents.
+//
+// The package follows semantic versioning: the exported identifiers below, along with the model types they refer
+// to in package project, only change in a backwards incompatible way within a new major version of the module,
+// while new ones may be added in any minor version.
+package spec
+
+import (
+	"github.com/raitonbl/ant/internal"
+	"github.com/raitonbl/ant/internal/bundle"
+	"github.com/raitonbl/ant/pkg/project"
+)
+
+type Specification = project.Specification
+type Command = project.Command
+type Parameter = project.Parameter
+type Exit = project.Exit
+type Schema = project.Schema
+type Extensions = project.Extensions
+type Deprecation = project.Deprecation
+type Constraints = project.Constraints
+type Reference = project.Reference
+type ResolvedCommand = project.ResolvedCommand
+type ResolvedParameter = project.ResolvedParameter
+type ResolvedExit = project.ResolvedExit
+
+type In = project.In
+type SchemaType = project.SchemaType
+type SchemaFormat = project.SchemaFormat
+
+const (
+	Flags     = project.Flags
+	Arguments = project.Arguments
+)
+
+const (
+	String = project.String
+	Number = project.Number
+	Bool   = project.Bool
+	Array  = project.Array
+)
+
+const (
+	Byte     = project.Byte
+	Int64    = project.Int64
+	Int32    = project.Int32
+	Float    = project.Float
+	Double   = project.Double
+	Date     = project.Date
+	Binary   = project.Binary
+	DateTime = project.DateTime
+)
+
+type Format string
+
+const (
+	Json Format = "json"
+	Yaml Format = "yaml"
+)
+
+// Document is a specification together with the files it refers to
+type Document struct {
+	ctx           internal.ProjectContext
+	specification *Specification
+}
+
+// Load reads the specification from a json, yaml or yml file, including every file referenced through refers-to
+func Load(path string) (*Document, error) {
+	ctx, err := internal.GetContext(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newDocument(ctx)
+}
+
+// Parse reads the specification from its content. Files referenced through refers-to are looked up
+// relative to the working directory
+func Parse(binary []byte, format Format) (*Document, error) {
+
+	if format != Json && format != Yaml {
+		return nil, internal.GetProblemFactory().GetUnsupportedFormat(string(format))
+	}
+
+	return newDocument(internal.NewContext("index."+string(format), binary))
+}
+
+func newDocument(ctx internal.ProjectContext) (*Document, error) {
+	specification, err := ctx.GetDocument()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Document{ctx: ctx, specification: specification}, nil
+}
+
+// GetSpecification returns the specification as written, where refers-to isn't followed
+func (instance *Document) GetSpecification() *Specification {
+	return instance.specification
+}
+
+// GetCommands returns the command tree, where each command has its concrete parameters and exit
+func (instance *Document) GetCommands() []*ResolvedCommand {
+	return instance.specification.GetCommands()
+}
+
+// GetCommand returns the command invoked through the path (e.g. build stack) or nil when there's no such command
+func (instance *Document) GetCommand(path ...string) *ResolvedCommand {
+	return instance.specification.GetCommand(path...)
+}
+
+// Resolve returns a copy of the specification where every refers-to is replaced by the definition it refers to
+func (instance *Document) Resolve() (*Specification, error) {
+	return bundle.Bundle(instance.ctx, false)
+}
+
+// Serialize writes the specification in the given format, keeping every extension
+func (instance *Document) Serialize(format Format) ([]byte, error) {
+
+	if format != Json && format != Yaml {
+		return nil, internal.GetProblemFactory().GetUnsupportedFormat(string(format))
+	}
+
+	return internal.Serialize(instance.specification, "index."+string(format))
+}
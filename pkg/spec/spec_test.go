@@ -0,0 +1,134 @@
+package spec
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func TestLoad_where_refers_to_other_files(t *testing.T) {
+	document, err := Load("../../internal/commands/lint/testdata/index-064.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "cli", *document.GetSpecification().Name)
+	assert.Equal(t, "./shared/params.yaml#/parameters/verbose", *document.GetSpecification().Subcommands[0].Parameters[1].RefersTo)
+
+	specification, err := document.Resolve()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "verbose", *specification.Subcommands[0].Parameters[1].Name)
+	assert.Equal(t, []string{"quiet", "normal", "debug"}, specification.Subcommands[0].Parameters[1].Schema.Enum)
+
+	violations, err := Lint(document)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Empty(t, violations)
+}
+
+func TestLoad_where_file_doesnt_exist(t *testing.T) {
+	_, err := Load("index.json")
+	assert.NotNil(t, err)
+}
+
+func TestLint_where_document_is_nil(t *testing.T) {
+	_, err := Lint(nil)
+	assert.NotNil(t, err)
+}
+
+func TestParse_from_json(t *testing.T) {
+	binary, err := os.ReadFile("../../internal/commands/lint/testdata/index-018.json")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	document, err := Parse(binary, Json)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	violations, err := Lint(document)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 1, len(violations))
+	assert.Equal(t, Error, violations[0].Severity)
+	assert.True(t, IsFailure(violations, false))
+
+	binary, err = document.Serialize(Yaml)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	document, err = Parse(binary, Yaml)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "cli", *document.GetSpecification().Name)
+}
+
+func TestParse_where_format_is_unsupported(t *testing.T) {
+	_, err := Parse([]byte("name: cli"), "toml")
+	assert.NotNil(t, err)
+}
+
+func TestDocument_GetCommand(t *testing.T) {
+	document, err := Load("../../internal/commands/lint/testdata/index-003.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	command := document.GetCommand("build", "stack")
+
+	assert.Equal(t, []string{"build", "stack"}, command.Path)
+	assert.Equal(t, "filename", *command.GetArguments()[0].Parameter.Name)
+	assert.Equal(t, "filename", command.GetArguments()[0].RefersTo)
+	assert.Equal(t, "stack", *command.GetFlags()[0].Parameter.Name)
+	assert.Equal(t, []string{"java", "python3", "golang"}, command.GetFlags()[0].Parameter.Schema.Enum)
+	assert.Nil(t, document.GetCommand("build", "unknown"))
+}
+
+func TestParse_where_deprecated(t *testing.T) {
+	document, err := Load("../../internal/commands/lint/testdata/index-068.yaml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	binary, err := document.Serialize(Json)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	document, err = Parse(binary, Json)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	command := document.GetCommand("build")
+
+	assert.True(t, command.Command.IsDeprecated())
+	assert.Equal(t, "compile", *command.Command.Deprecated.ReplacedBy)
+	assert.True(t, command.GetFlags()[2].Parameter.IsDeprecated())
+	assert.Nil(t, command.GetFlags()[2].Parameter.Deprecated.Since)
+	assert.False(t, document.GetCommand("compile").Command.IsDeprecated())
+	assert.Contains(t, string(binary), `"deprecated": true`)
+}
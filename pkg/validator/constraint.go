@@ -0,0 +1,107 @@
+package validator
+
+import (
+	"fmt"
+	"github.com/raitonbl/ant/pkg/spec"
+	"strings"
+)
+
+// doValidateConstraints verifies that the parameters which are given comply with the constraints of the command
+func (instance *Validator) doValidateConstraints(invocation *Invocation) error {
+	constraints := invocation.Command.Command.Constraints
+
+	if constraints == nil {
+		return nil
+	}
+
+	for _, group := range constraints.Exclusive {
+		given := getGiven(invocation, group)
+
+		if len(given) > 1 {
+			return instance.newError(ConflictingParameter, invocation.Command, given[1].GetName(),
+				fmt.Sprintf("%s cannot be combined with %s", getLabel(given[0]), getLabel(given[1])))
+		}
+	}
+
+	for _, group := range constraints.RequiredTogether {
+		given := getGiven(invocation, group)
+
+		if len(given) == 0 {
+			continue
+		}
+
+		for _, each := range getParameters(invocation.Command, group) {
+			if !invocation.IsSet(each.GetName()) {
+				return instance.newError(MissingParameter, invocation.Command, each.GetName(),
+					fmt.Sprintf("missing %s, required by %s", getLabel(each), getLabel(given[0])))
+			}
+		}
+	}
+
+	for _, group := range constraints.AtLeastOneOf {
+		if len(getGiven(invocation, group)) > 0 {
+			continue
+		}
+
+		labels := make([]string, 0)
+
+		for _, each := range getParameters(invocation.Command, group) {
+			labels = append(labels, getLabel(each))
+		}
+
+		return instance.newError(MissingParameter, invocation.Command, "", fmt.Sprintf("missing one of %s", strings.Join(labels, ", ")))
+	}
+
+	return nil
+}
+
+// doApplyPrecedence drops the values bound from an environment variable out of each exclusive group where a parameter is given
+// through the arguments, since the arguments take precedence over the environment
+func doApplyPrecedence(invocation *Invocation) {
+	constraints := invocation.Command.Command.Constraints
+
+	if constraints == nil {
+		return
+	}
+
+	for _, group := range constraints.Exclusive {
+		given := getGiven(invocation, group)
+		isExplicit := false
+
+		for _, each := range given {
+			isExplicit = isExplicit || !invocation.fromEnv[each.GetName()]
+		}
+
+		for _, each := range given {
+			if isExplicit && invocation.fromEnv[each.GetName()] {
+				invocation.doUnbind(each)
+			}
+		}
+	}
+}
+
+// getGiven returns the parameters of the group which are given
+func getGiven(invocation *Invocation, group []string) []*spec.Parameter {
+	array := make([]*spec.Parameter, 0)
+
+	for _, each := range getParameters(invocation.Command, group) {
+		if invocation.IsSet(each.GetName()) {
+			array = append(array, each)
+		}
+	}
+
+	return array
+}
+
+// getParameters returns the parameters which the group references, ignoring the references which cannot be resolved
+func getParameters(command *spec.ResolvedCommand, group []string) []*spec.Parameter {
+	array := make([]*spec.Parameter, 0)
+
+	for _, reference := range group {
+		if param := command.GetParameter(reference); param != nil {
+			array = append(array, param.Parameter)
+		}
+	}
+
+	return array
+}
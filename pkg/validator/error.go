@@ -0,0 +1,81 @@
+package validator
+
+import (
+	"fmt"
+	"github.com/raitonbl/ant/pkg/spec"
+	"os"
+)
+
+type Kind string
+
+const (
+	UnknownCommand       Kind = "unknown-command"
+	UnknownParameter     Kind = "unknown-parameter"
+	MissingParameter     Kind = "missing-parameter"
+	InvalidValue         Kind = "invalid-value"
+	ConflictingParameter Kind = "conflicting-parameter"
+)
+
+// DefaultExitCode is the exit code of an error which kind isn't mapped into an exit of the specification
+const DefaultExitCode = 2
+
+type Error struct {
+	Kind      Kind
+	Parameter string
+	Message   string
+	Code      int
+	Exit      *spec.Exit
+}
+
+func (instance *Error) Error() string {
+	return instance.Message
+}
+
+// Exit terminates the process with the exit code of the error, after writing the error into the standard error
+func Exit(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(GetExitCode(err))
+}
+
+func GetExitCode(err error) int {
+
+	if err == nil {
+		return 0
+	}
+
+	if object, isError := err.(*Error); isError {
+		return object.Code
+	}
+
+	return 1
+}
+
+func (instance *Validator) newError(kind Kind, command *spec.ResolvedCommand, parameter string, message string) *Error {
+	object := &Error{Kind: kind, Parameter: parameter, Message: message, Code: DefaultExitCode}
+	object.Exit = instance.getExit(kind, command)
+
+	if object.Exit != nil && object.Exit.Code != nil {
+		object.Code = *object.Exit.Code
+	}
+
+	return object
+}
+
+// getExit looks up the exit of the kind among the exit of the command, followed by the exit of the specification
+func (instance *Validator) getExit(kind Kind, command *spec.ResolvedCommand) *spec.Exit {
+	id := string(kind)
+
+	if value, isPresent := instance.configuration.Exit[kind]; isPresent {
+		id = value
+	}
+
+	if command != nil {
+		for _, each := range command.Exit {
+			if each.Exit.Id != nil && *each.Exit.Id == id {
+				return each.Exit
+			}
+		}
+	}
+
+	return instance.specification.GetExit(id)
+}
@@ -0,0 +1,71 @@
+package validator
+
+import (
+	"github.com/raitonbl/ant/pkg/converter"
+	"github.com/raitonbl/ant/pkg/spec"
+)
+
+// Invocation is the command invoked through the arguments, together with the value of each of its parameters
+type Invocation struct {
+	Command *spec.ResolvedCommand
+	// Warnings tells which of the commands and parameters used are deprecated, which doesn't prevent the invocation
+	Warnings []string
+	values   map[string][]string
+	given    map[string]bool
+	// fromEnv holds the parameters which are bound from their environment variable, rather than from the arguments
+	fromEnv map[string]bool
+}
+
+// Get returns the value of the parameter, which is its default when the parameter isn't given
+func (instance *Invocation) Get(name string) string {
+	values := instance.values[name]
+
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// GetValues returns every value of the parameter, since a parameter of type array can be given more than once
+func (instance *Invocation) GetValues(name string) []string {
+	return instance.values[name]
+}
+
+// IsSet determines whether the parameter is given, either as an argument or through its environment variable, as opposed to having its default value
+func (instance *Invocation) IsSet(name string) bool {
+	return instance.given[name]
+}
+
+func (instance *Invocation) doAdd(name string, value string) {
+	instance.values[name] = append(instance.values[name], value)
+	instance.given[name] = true
+}
+
+// doUnbind drops the value of the parameter, which falls back to its default, if any
+func (instance *Invocation) doUnbind(param *spec.Parameter) {
+	delete(instance.values, param.GetName())
+	delete(instance.given, param.GetName())
+	delete(instance.fromEnv, param.GetName())
+
+	if param.DefaultValue != nil {
+		instance.values[param.GetName()] = []string{*param.DefaultValue}
+	}
+}
+
+// GetValue returns the value of the parameter as the Go value of its schema (e.g. int32 or []time.Time), as given by converter.Convert
+func (instance *Invocation) GetValue(name string) (interface{}, error) {
+	var schema *spec.Schema
+
+	for _, each := range instance.Command.Parameters {
+		if each.Parameter.GetName() == name {
+			schema = each.Parameter.Schema
+		}
+	}
+
+	if schema != nil && schema.TypeOf != nil && *schema.TypeOf == spec.Array {
+		return converter.ConvertAll(schema, instance.GetValues(name))
+	}
+
+	return converter.Convert(schema, instance.Get(name))
+}
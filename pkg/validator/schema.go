@@ -0,0 +1,147 @@
+package validator
+
+import (
+	"fmt"
+	"github.com/raitonbl/ant/pkg/converter"
+	"github.com/raitonbl/ant/pkg/spec"
+	"math"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// getViolation returns the reason why the values don't comply with the schema, or an empty text when they do
+func getViolation(schema *spec.Schema, values []string) string {
+
+	if schema == nil {
+		return ""
+	}
+
+	if schema.TypeOf == nil || *schema.TypeOf != spec.Array {
+
+		if len(values) > 1 {
+			return "must be given only once"
+		}
+
+		return getValueViolation(schema, values[0])
+	}
+
+	if schema.MinItems != nil && len(values) < *schema.MinItems {
+		return fmt.Sprintf("must be given at least %d times", *schema.MinItems)
+	}
+
+	if schema.MaxItems != nil && len(values) > *schema.MaxItems {
+		return fmt.Sprintf("must be given at most %d times", *schema.MaxItems)
+	}
+
+	if schema.UniqueItems != nil && *schema.UniqueItems && hasDuplicates(values) {
+		return "must not be repeated"
+	}
+
+	for _, each := range values {
+		if reason := getValueViolation(schema.Items, each); reason != "" {
+			return reason
+		}
+	}
+
+	return ""
+}
+
+func getValueViolation(schema *spec.Schema, value string) string {
+
+	if schema == nil {
+		return ""
+	}
+
+	if len(schema.Enum) > 0 && !contains(schema.Enum, value) {
+		return fmt.Sprintf("must be one of %s", strings.Join(schema.Enum, ", "))
+	}
+
+	object, err := converter.Convert(schema, value)
+
+	if err != nil {
+		return err.(*converter.Error).Reason
+	}
+
+	switch number := object.(type) {
+	case int32:
+		return getNumberViolation(schema, float64(number))
+	case int64:
+		return getNumberViolation(schema, float64(number))
+	case float64:
+		return getNumberViolation(schema, number)
+	case bool:
+		return ""
+	}
+
+	return getTextViolation(schema, value)
+}
+
+func getNumberViolation(schema *spec.Schema, number float64) string {
+
+	if schema.Minimum != nil && schema.ExclusiveMinimum != nil && *schema.ExclusiveMinimum && number <= float64(*schema.Minimum) {
+		return fmt.Sprintf("must be greater than %d", *schema.Minimum)
+	}
+
+	if schema.Minimum != nil && number < float64(*schema.Minimum) {
+		return fmt.Sprintf("must be greater than or equal to %d", *schema.Minimum)
+	}
+
+	if schema.Maximum != nil && schema.ExclusiveMaximum != nil && *schema.ExclusiveMaximum && number >= float64(*schema.Maximum) {
+		return fmt.Sprintf("must be lesser than %d", *schema.Maximum)
+	}
+
+	if schema.Maximum != nil && number > float64(*schema.Maximum) {
+		return fmt.Sprintf("must be lesser than or equal to %d", *schema.Maximum)
+	}
+
+	if schema.MultipleOf != nil && *schema.MultipleOf != 0 && math.Mod(number, float64(*schema.MultipleOf)) != 0 {
+		return fmt.Sprintf("must be a multiple of %d", *schema.MultipleOf)
+	}
+
+	return ""
+}
+
+func getTextViolation(schema *spec.Schema, value string) string {
+	length := utf8.RuneCountInString(value)
+
+	if schema.MinLength != nil && length < *schema.MinLength {
+		return fmt.Sprintf("must have at least %d characters", *schema.MinLength)
+	}
+
+	if schema.MaxLength != nil && length > *schema.MaxLength {
+		return fmt.Sprintf("must have at most %d characters", *schema.MaxLength)
+	}
+
+	if schema.Pattern != nil {
+		expression, err := regexp.Compile(*schema.Pattern)
+
+		if err != nil || !expression.MatchString(value) {
+			return fmt.Sprintf("must match %s", *schema.Pattern)
+		}
+	}
+
+	return ""
+}
+
+func hasDuplicates(values []string) bool {
+	cache := make(map[string]bool)
+
+	for _, each := range values {
+		if cache[each] {
+			return true
+		}
+		cache[each] = true
+	}
+
+	return false
+}
+
+func contains(array []string, value string) bool {
+	for _, each := range array {
+		if each == value {
+			return true
+		}
+	}
+	return false
+}
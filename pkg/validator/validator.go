@@ -0,0 +1,340 @@
+// Package validator validates the arguments of a process against an ant CLI specification, which is usually embedded into the binary.
+package validator
+
+import (
+	"fmt"
+	"github.com/raitonbl/ant/pkg/spec"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type Configuration struct {
+	// Exit maps each kind of error into the id of the exit it terminates with. By default, the exit which id is the kind itself is used
+	Exit map[Kind]string
+}
+
+type Validator struct {
+	specification *spec.Specification
+	configuration *Configuration
+}
+
+func New(document *spec.Document) *Validator {
+	return NewWith(document, nil)
+}
+
+func NewWith(document *spec.Document, configuration *Configuration) *Validator {
+
+	if configuration == nil {
+		configuration = &Configuration{}
+	}
+
+	return &Validator{specification: document.GetSpecification(), configuration: configuration}
+}
+
+// ValidateArgs validates the arguments of the process, as given by os.Args
+func (instance *Validator) ValidateArgs() (*Invocation, error) {
+	return instance.Validate(os.Args[1:])
+}
+
+// Validate resolves the command invoked through the arguments and binds its arguments and flags, which must comply with their schema
+// and with the constraints of the command
+func (instance *Validator) Validate(args []string) (*Invocation, error) {
+	command, remaining, err := instance.getCommand(args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	invocation := &Invocation{Command: command, values: make(map[string][]string), given: make(map[string]bool),
+		fromEnv: make(map[string]bool)}
+
+	positional, err := instance.doBindFlags(invocation, remaining)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = instance.doBindArguments(invocation, positional); err != nil {
+		return nil, err
+	}
+
+	if err = instance.doApplyDefaults(invocation); err != nil {
+		return nil, err
+	}
+
+	doApplyPrecedence(invocation)
+	doAddWarnings(invocation)
+
+	if err = instance.doValidateValues(invocation); err != nil {
+		return invocation, err
+	}
+
+	return invocation, instance.doValidateConstraints(invocation)
+}
+
+func (instance *Validator) getCommand(args []string) (*spec.ResolvedCommand, []string, error) {
+	array := instance.specification.GetCommands()
+	var command *spec.ResolvedCommand
+
+	for index, arg := range args {
+		if command != nil && command.IsLeaf() {
+			return command, args[index:], nil
+		}
+
+		next := getSubcommand(array, arg)
+
+		if next == nil {
+			return nil, nil, instance.newError(UnknownCommand, command, "", fmt.Sprintf("unknown command '%s'", strings.Join(append(getPath(command), arg), " ")))
+		}
+
+		command = next
+		array = command.Subcommands
+	}
+
+	if command == nil || !command.IsLeaf() {
+		return nil, nil, instance.newError(UnknownCommand, command, "", fmt.Sprintf("missing command after '%s'", strings.Join(append([]string{getText(instance.specification.Name)}, getPath(command)...), " ")))
+	}
+
+	return command, make([]string, 0), nil
+}
+
+// doBindFlags binds every flag, returning the remaining positional values
+func (instance *Validator) doBindFlags(invocation *Invocation, args []string) ([]string, error) {
+	positional := make([]string, 0)
+	isPositionalOnly := false
+
+	for index := 0; index < len(args); index++ {
+		arg := args[index]
+
+		if isPositionalOnly || !isFlag(arg) {
+			positional = append(positional, arg)
+			continue
+		}
+
+		if arg == "--" {
+			isPositionalOnly = true
+			continue
+		}
+
+		name, value, hasValue := strings.TrimLeft(arg, "-"), "", false
+
+		if separator := strings.Index(name, "="); separator != -1 {
+			name, value, hasValue = name[:separator], name[separator+1:], true
+		}
+
+		param := getFlag(invocation.Command, name, !strings.HasPrefix(arg, "--"))
+
+		if param == nil {
+			return nil, instance.newError(UnknownParameter, invocation.Command, name, fmt.Sprintf("unknown flag '%s'", strings.SplitN(arg, "=", 2)[0]))
+		}
+
+		if !hasValue && isBoolean(param.Parameter.Schema) {
+			value, hasValue = "true", true
+		}
+
+		if !hasValue && index+1 < len(args) {
+			index++
+			value, hasValue = args[index], true
+		}
+
+		if !hasValue {
+			return nil, instance.newError(MissingParameter, invocation.Command, param.Parameter.GetName(), fmt.Sprintf("missing value of %s", getLabel(param.Parameter)))
+		}
+
+		invocation.doAdd(param.Parameter.GetName(), value)
+	}
+
+	return positional, nil
+}
+
+// doBindArguments binds each positional value by index, where the remaining values are bound to the last argument when it is variadic
+func (instance *Validator) doBindArguments(invocation *Invocation, positional []string) error {
+	arguments := invocation.Command.GetArguments()
+
+	for index, value := range positional {
+
+		if index >= len(arguments) && (len(arguments) == 0 || !arguments[len(arguments)-1].Parameter.IsVariadic()) {
+			return instance.newError(UnknownParameter, invocation.Command, "", fmt.Sprintf("unexpected argument '%s'", value))
+		}
+
+		if index >= len(arguments) {
+			index = len(arguments) - 1
+		}
+
+		invocation.doAdd(arguments[index].Parameter.GetName(), value)
+	}
+
+	return nil
+}
+
+// doApplyDefaults binds the parameters which aren't given from their environment variable or, when it isn't set, from their default
+func (instance *Validator) doApplyDefaults(invocation *Invocation) error {
+
+	for _, each := range invocation.Command.Parameters {
+		param := each.Parameter
+
+		if invocation.IsSet(param.GetName()) {
+			continue
+		}
+
+		if value, isBound := instance.getEnv(param); isBound {
+			invocation.doAdd(param.GetName(), value)
+			invocation.fromEnv[param.GetName()] = true
+		} else if param.DefaultValue != nil {
+			invocation.values[param.GetName()] = []string{*param.DefaultValue}
+		} else if param.IsRequired() {
+			return instance.newError(MissingParameter, invocation.Command, param.GetName(), fmt.Sprintf("missing %s", getLabel(param)))
+		}
+	}
+
+	return nil
+}
+
+// getEnv returns the value of the environment variable the parameter is bound to, which is only bound when the variable isn't blank
+func (instance *Validator) getEnv(param *spec.Parameter) (string, bool) {
+	name := instance.specification.GetEnv(param)
+
+	if name == "" {
+		return "", false
+	}
+
+	value := os.Getenv(name)
+
+	return value, value != ""
+}
+
+func (instance *Validator) doValidateValues(invocation *Invocation) error {
+
+	for _, each := range invocation.Command.Parameters {
+		param := each.Parameter
+
+		if !invocation.IsSet(param.GetName()) {
+			continue
+		}
+
+		if reason := getViolation(param.Schema, invocation.GetValues(param.GetName())); reason != "" {
+			return instance.newError(InvalidValue, invocation.Command, param.GetName(), fmt.Sprintf("invalid %s: %s", getLabel(param), reason))
+		}
+	}
+
+	return nil
+}
+
+// doAddWarnings warns about the deprecated commands of the path and the deprecated parameters which are given
+func doAddWarnings(invocation *Invocation) {
+	commands := make([]*spec.ResolvedCommand, 0)
+
+	for command := invocation.Command; command != nil; command = command.Parent {
+		commands = append([]*spec.ResolvedCommand{command}, commands...)
+	}
+
+	for _, each := range commands {
+		if each.Command.IsDeprecated() {
+			invocation.Warnings = append(invocation.Warnings, getWarning(fmt.Sprintf("command '%s'", strings.Join(each.Path, " ")),
+				each.Command.Deprecated, fmt.Sprintf("command '%s'", getText(each.Command.Deprecated.ReplacedBy))))
+		}
+	}
+
+	for _, each := range invocation.Command.Parameters {
+		param := each.Parameter
+
+		if param.IsDeprecated() && invocation.IsSet(param.GetName()) {
+			invocation.Warnings = append(invocation.Warnings, getWarning(getLabel(param), param.Deprecated,
+				getReplacement(invocation.Command, getText(param.Deprecated.ReplacedBy))))
+		}
+	}
+}
+
+// getWarning returns the warning about the deprecated command or parameter, where replacement labels whatever replaces it
+func getWarning(label string, deprecation *spec.Deprecation, replacement string) string {
+	txt := label + " is deprecated"
+
+	if deprecation.Since != nil {
+		txt += " since " + *deprecation.Since
+	}
+
+	if deprecation.ReplacedBy != nil {
+		txt += ", use " + replacement + " instead"
+	}
+
+	if deprecation.Message != nil {
+		txt += ": " + strings.Join(strings.Fields(*deprecation.Message), " ")
+	}
+
+	return txt
+}
+
+func getReplacement(command *spec.ResolvedCommand, name string) string {
+	for _, each := range command.Parameters {
+		if each.Parameter.GetName() == name {
+			return getLabel(each.Parameter)
+		}
+	}
+	return fmt.Sprintf("'%s'", name)
+}
+
+func getSubcommand(array []*spec.ResolvedCommand, name string) *spec.ResolvedCommand {
+	for _, each := range array {
+		if each.Command.IsNamed(name) {
+			return each
+		}
+	}
+	return nil
+}
+
+func getFlag(command *spec.ResolvedCommand, name string, isShortForm bool) *spec.ResolvedParameter {
+	for _, each := range command.GetFlags() {
+		if isShortForm && each.Parameter.ShortForm != nil && *each.Parameter.ShortForm == name {
+			return each
+		}
+
+		if !isShortForm && each.Parameter.GetName() == name {
+			return each
+		}
+	}
+	return nil
+}
+
+// isFlag determines whether the value is a flag, where negative numbers are positional values
+func isFlag(value string) bool {
+
+	if !strings.HasPrefix(value, "-") || value == "-" {
+		return false
+	}
+
+	_, err := strconv.ParseFloat(value, 64)
+
+	return err != nil
+}
+
+func getLabel(param *spec.Parameter) string {
+
+	if param.IsArgument() {
+		return fmt.Sprintf("argument '%s'", param.GetName())
+	}
+
+	return fmt.Sprintf("flag '--%s'", param.GetName())
+}
+
+func getPath(command *spec.ResolvedCommand) []string {
+
+	if command == nil {
+		return make([]string, 0)
+	}
+
+	return command.Path
+}
+
+func getText(value *string) string {
+
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+func isBoolean(schema *spec.Schema) bool {
+	return schema != nil && schema.TypeOf != nil && *schema.TypeOf == spec.Bool
+}
@@ -0,0 +1,355 @@
+package validator
+
+import (
+	"github.com/raitonbl/ant/pkg/spec"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+const document = `
+name: cli
+env-prefix: CLI_
+exit:
+  - id: invalid-value
+    code: 3
+    message: the value of a parameter is invalid
+  - id: usage
+    code: 64
+    message: the command is used the wrong way
+commands:
+  - name: build
+    aliases: [b]
+    commands:
+      - name: stack
+        parameters:
+          - in: flags
+            name: verbose
+            short-form: v
+            schema:
+              type: boolean
+          - in: arguments
+            name: directory
+            index: 0
+          - in: arguments
+            name: target
+            index: 1
+            default: dist
+          - in: flags
+            name: language
+            short-form: l
+            required: true
+            schema:
+              enum: [java, golang]
+          - in: flags
+            name: release
+            schema:
+              type: string
+              format: date
+          - in: flags
+            name: port
+            env: PORT
+            schema:
+              type: number
+              format: int32
+              minimum: 1
+          - in: flags
+            name: tag
+            schema:
+              type: array
+              max-items: 2
+              items:
+                type: string
+                pattern: ^[a-z]+$
+          - in: flags
+            name: lang
+            deprecated:
+              since: 1.1.0
+              replaced-by: language
+        exit:
+          - id: unknown-parameter
+            code: 5
+            message: the parameter is unknown
+  - name: login
+    parameters:
+      - in: flags
+        name: user
+      - in: flags
+        name: password
+      - in: flags
+        name: token
+        env: TOKEN
+    constraints:
+      exclusive:
+        - [user, token]
+      required-together:
+        - [user, password]
+      at-least-one-of:
+        - [user, token]
+  - name: remove
+    parameters:
+      - in: arguments
+        name: files
+        index: 0
+        schema:
+          type: array
+          max-items: 3
+          items:
+            type: string
+`
+
+func TestValidate(t *testing.T) {
+	invocation, err := getValidator(t, nil).Validate([]string{"build", "stack", "-v", "src", "--language=golang", "--tag", "api", "--tag", "web", "--port", "8080"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []string{"build", "stack"}, invocation.Command.Path)
+	assert.Equal(t, "src", invocation.Get("directory"))
+	assert.Equal(t, "dist", invocation.Get("target"))
+	assert.Equal(t, "golang", invocation.Get("language"))
+	assert.Equal(t, "true", invocation.Get("verbose"))
+	assert.Equal(t, "8080", invocation.Get("port"))
+	assert.Equal(t, []string{"api", "web"}, invocation.GetValues("tag"))
+	assert.True(t, invocation.IsSet("verbose"))
+	assert.False(t, invocation.IsSet("target"))
+
+	port, err := invocation.GetValue("port")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, int32(8080), port)
+
+	tags, err := invocation.GetValue("tag")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []string{"api", "web"}, tags)
+}
+
+func TestValidate_where_command_is_alias(t *testing.T) {
+	invocation, err := getValidator(t, nil).Validate([]string{"b", "stack", "src", "-l", "java"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []string{"build", "stack"}, invocation.Command.Path)
+}
+
+func TestValidate_where_arguments_follow_terminator(t *testing.T) {
+	invocation, err := getValidator(t, nil).Validate([]string{"build", "stack", "-l", "java", "--", "-src", "-1"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "-src", invocation.Get("directory"))
+	assert.Equal(t, "-1", invocation.Get("target"))
+}
+
+func TestValidate_where_flag_is_deprecated(t *testing.T) {
+	invocation, err := getValidator(t, nil).Validate([]string{"build", "stack", "src", "-l", "java", "--lang", "java"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []string{"flag '--lang' is deprecated since 1.1.0, use flag '--language' instead"}, invocation.Warnings)
+}
+
+func TestValidate_where_flag_is_bound_to_env(t *testing.T) {
+	os.Setenv("CLI_PORT", "9090")
+	defer os.Unsetenv("CLI_PORT")
+
+	invocation, err := getValidator(t, nil).Validate([]string{"build", "stack", "src", "-l", "java"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "9090", invocation.Get("port"))
+	assert.True(t, invocation.IsSet("port"))
+
+	invocation, err = getValidator(t, nil).Validate([]string{"build", "stack", "src", "-l", "java", "--port", "8080"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "8080", invocation.Get("port"))
+}
+
+func TestValidate_where_env_is_invalid(t *testing.T) {
+	os.Setenv("CLI_PORT", "http")
+	defer os.Unsetenv("CLI_PORT")
+
+	doValidateTest(t, nil, []string{"build", "stack", "src", "-l", "java"}, InvalidValue, "invalid flag '--port': must be an integer", 3)
+}
+
+func TestValidate_where_argument_is_variadic(t *testing.T) {
+	invocation, err := getValidator(t, nil).Validate([]string{"remove", "a.txt", "b.txt", "c.txt"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []string{"a.txt", "b.txt", "c.txt"}, invocation.GetValues("files"))
+}
+
+func TestValidate_where_variadic_argument_exceeds_max_items(t *testing.T) {
+	doValidateTest(t, nil, []string{"remove", "a.txt", "b.txt", "c.txt", "d.txt"}, InvalidValue,
+		"invalid argument 'files': must be given at most 3 times", 3)
+}
+
+func TestValidate_where_command_is_unknown(t *testing.T) {
+	doValidateTest(t, nil, []string{"build", "image"}, UnknownCommand, "unknown command 'build image'", DefaultExitCode)
+}
+
+func TestValidate_where_command_is_missing(t *testing.T) {
+	doValidateTest(t, nil, []string{"build"}, UnknownCommand, "missing command after 'cli build'", DefaultExitCode)
+}
+
+func TestValidate_where_flag_is_unknown(t *testing.T) {
+	doValidateTest(t, nil, []string{"build", "stack", "src", "--stack=java"}, UnknownParameter, "unknown flag '--stack'", 5)
+}
+
+func TestValidate_where_argument_is_unexpected(t *testing.T) {
+	doValidateTest(t, nil, []string{"build", "stack", "src", "dist", "bin", "-l", "java"}, UnknownParameter, "unexpected argument 'bin'", 5)
+}
+
+func TestValidate_where_flag_is_missing(t *testing.T) {
+	doValidateTest(t, nil, []string{"build", "stack", "src"}, MissingParameter, "missing flag '--language'", DefaultExitCode)
+}
+
+func TestValidate_where_argument_is_missing(t *testing.T) {
+	doValidateTest(t, nil, []string{"build", "stack", "-l", "java"}, MissingParameter, "missing argument 'directory'", DefaultExitCode)
+}
+
+func TestValidate_where_value_is_missing(t *testing.T) {
+	doValidateTest(t, nil, []string{"build", "stack", "src", "--language"}, MissingParameter, "missing value of flag '--language'", DefaultExitCode)
+}
+
+func TestValidate_where_value_isnt_in_enum(t *testing.T) {
+	doValidateTest(t, nil, []string{"build", "stack", "src", "-l", "rust"}, InvalidValue, "invalid flag '--language': must be one of java, golang", 3)
+}
+
+func TestValidate_where_value_isnt_date(t *testing.T) {
+	doValidateTest(t, nil, []string{"build", "stack", "src", "-l", "java", "--release", "2022-13-01"}, InvalidValue,
+		"invalid flag '--release': must be a date (yyyy-mm-dd)", 3)
+}
+
+func TestValidate_where_value_isnt_int32(t *testing.T) {
+	doValidateTest(t, nil, []string{"build", "stack", "src", "-l", "java", "--port", "3000000000"}, InvalidValue,
+		"invalid flag '--port': must be within the range of int32", 3)
+}
+
+func TestValidate_where_value_is_lesser_than_minimum(t *testing.T) {
+	doValidateTest(t, nil, []string{"build", "stack", "src", "-l", "java", "--port", "0"}, InvalidValue,
+		"invalid flag '--port': must be greater than or equal to 1", 3)
+}
+
+func TestValidate_where_value_doesnt_match_pattern(t *testing.T) {
+	doValidateTest(t, nil, []string{"build", "stack", "src", "-l", "java", "--tag", "API"}, InvalidValue,
+		"invalid flag '--tag': must match ^[a-z]+$", 3)
+}
+
+func TestValidate_where_values_exceed_max_items(t *testing.T) {
+	doValidateTest(t, nil, []string{"build", "stack", "src", "-l", "java", "--tag", "a", "--tag", "b", "--tag", "c"}, InvalidValue,
+		"invalid flag '--tag': must be given at most 2 times", 3)
+}
+
+func TestValidate_where_flag_is_repeated(t *testing.T) {
+	doValidateTest(t, nil, []string{"build", "stack", "src", "-l", "java", "-l", "golang"}, InvalidValue,
+		"invalid flag '--language': must be given only once", 3)
+}
+
+func TestValidate_where_constraints_are_satisfied(t *testing.T) {
+	invocation, err := getValidator(t, nil).Validate([]string{"login", "--user", "john", "--password", "secret"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "john", invocation.Get("user"))
+}
+
+func TestValidate_where_exclusive_flag_is_bound_to_env(t *testing.T) {
+	os.Setenv("CLI_TOKEN", "abc")
+	defer os.Unsetenv("CLI_TOKEN")
+
+	invocation, err := getValidator(t, nil).Validate([]string{"login", "--user", "john", "--password", "secret"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.False(t, invocation.IsSet("token"))
+	assert.Equal(t, "", invocation.Get("token"))
+
+	invocation, err = getValidator(t, nil).Validate([]string{"login"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "abc", invocation.Get("token"))
+}
+
+func TestValidate_where_flags_are_exclusive(t *testing.T) {
+	doValidateTest(t, nil, []string{"login", "--user", "john", "--password", "secret", "--token", "abc"}, ConflictingParameter,
+		"flag '--user' cannot be combined with flag '--token'", DefaultExitCode)
+}
+
+func TestValidate_where_flags_are_required_together(t *testing.T) {
+	doValidateTest(t, nil, []string{"login", "--user", "john"}, MissingParameter, "missing flag '--password', required by flag '--user'", DefaultExitCode)
+}
+
+func TestValidate_where_none_of_the_flags_is_given(t *testing.T) {
+	doValidateTest(t, nil, []string{"login"}, MissingParameter, "missing one of flag '--user', flag '--token'", DefaultExitCode)
+}
+
+func TestValidate_where_exit_is_configured(t *testing.T) {
+	configuration := &Configuration{Exit: map[Kind]string{UnknownCommand: "usage", MissingParameter: "usage"}}
+	doValidateTest(t, configuration, []string{"build"}, UnknownCommand, "missing command after 'cli build'", 64)
+	doValidateTest(t, configuration, []string{"build", "stack", "src"}, MissingParameter, "missing flag '--language'", 64)
+}
+
+func TestGetExitCode(t *testing.T) {
+	assert.Equal(t, 0, GetExitCode(nil))
+	assert.Equal(t, 3, GetExitCode(&Error{Code: 3}))
+	assert.Equal(t, 1, GetExitCode(assert.AnError))
+}
+
+func doValidateTest(t *testing.T, configuration *Configuration, args []string, kind Kind, message string, code int) {
+	_, err := getValidator(t, configuration).Validate(args)
+
+	if err == nil {
+		t.Fatal("error is expected")
+	}
+
+	object, isError := err.(*Error)
+
+	if !isError {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, kind, object.Kind)
+	assert.Equal(t, message, object.Message)
+	assert.Equal(t, code, object.Code)
+}
+
+func getValidator(t *testing.T, configuration *Configuration) *Validator {
+	specification, err := spec.Parse([]byte(document), spec.Yaml)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewWith(specification, configuration)
+}